@@ -15,6 +15,9 @@ import (
 
 	"github.com/RainJoe/mim/internal/gate"
 	"github.com/RainJoe/mim/internal/gate/config"
+	"github.com/RainJoe/mim/internal/gate/lb"
+	"github.com/RainJoe/mim/internal/registry"
+	rs "github.com/RainJoe/mim/pkg/redis"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 )
 
@@ -25,15 +28,28 @@ func main() {
 	}
 	log.Infof("loaded config %#v", config.Conf)
 	conf := config.Conf
-	conn, err := grpc.Dial(conf.WebSocketGate.LogicServerAddr, grpc.WithInsecure(), grpc.WithBlock())
+	pool := rs.NewRedisPool(&conf.Redis)
+	defer pool.Close()
+	conn, err := lb.Dial(&conf.Logic, pool)
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
 	}
 	defer conn.Close()
 	c := pb.NewLogicServiceClient(conn)
 
-	ws := gate.NewWebSocketGate(&conf.WebSocketGate, c)
-	go ws.InitHub()
+	blobs, err := gate.NewBlobService(&conf.Blob)
+	if err != nil {
+		log.Fatalf("failed to open blob store: %v", err)
+	}
+
+	gateID := conf.WebSocketGate.GateID()
+	hub := gate.NewHub(gateID, conf.WebSocketGate.HubShards)
+	go hub.Run()
+	if conf.WebSocketGate.PresenceInterval > 0 {
+		go hub.ReportPresence(c, time.Duration(conf.WebSocketGate.PresenceInterval)*time.Second)
+	}
+	workers := gate.NewWorkerPool(conf.WebSocketGate.Workers, conf.WebSocketGate.RequestQueue)
+	ws := gate.NewWebSocketGate(&conf.WebSocketGate, hub, c, blobs, workers)
 	router := http.NewServeMux()
 	router.HandleFunc("/ws", ws.ServeWs)
 	srv := http.Server{
@@ -47,21 +63,56 @@ func main() {
 			log.Fatal(err)
 		}
 	}()
+	var tcp *gate.TCPGate
+	if conf.TCPGate.Addr != "" {
+		tcp = gate.NewTCPGate(&conf.TCPGate, hub, c, blobs, workers)
+		go func() {
+			if err := tcp.ListenAndServe(); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+	var httpSrv *http.Server
+	if conf.HTTPGate.Addr != "" {
+		httpSrv = &http.Server{
+			Addr:    conf.HTTPGate.Addr,
+			Handler: gate.NewHTTPGate(&conf.HTTPGate, hub, c, blobs).Handler(),
+		}
+		go func() {
+			if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}()
+	}
 	go func() {
 		lis, err := net.Listen("tcp", conf.WebSocketGate.PushServerAddr)
 		if err != nil {
 			log.Fatalf("failed to listen: %v", err)
 		}
 		s := grpc.NewServer()
-		pbpush.RegisterPushServiceServer(s, gate.NewPushService(ws))
+		pbpush.RegisterPushServiceServer(s, gate.NewPushService(hub))
 		if err := s.Serve(lis); err != nil {
 			log.Fatalf("failed to serve: %v", err)
 		}
 	}()
+	gates := registry.New(pool, "gate", time.Duration(conf.WebSocketGate.RegistryTTL)*time.Second)
+	lease := gates.Keepalive(gateID, conf.WebSocketGate.PushAdvertise())
+	log.Infof("gate %s registered with push address %s", gateID, conf.WebSocketGate.PushAdvertise())
 	<-sig
+	lease.Stop()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Error(err)
 	}
+	if httpSrv != nil {
+		if err := httpSrv.Shutdown(ctx); err != nil {
+			log.Error(err)
+		}
+	}
+	if tcp != nil {
+		if err := tcp.Close(); err != nil {
+			log.Error(err)
+		}
+	}
 }
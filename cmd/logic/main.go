@@ -1,15 +1,26 @@
 package main
 
 import (
+	"expvar"
 	"flag"
 	"github.com/RainJoe/mim/internal/logic"
+	"github.com/RainJoe/mim/internal/logic/auth"
 	"github.com/RainJoe/mim/internal/logic/config"
 	"github.com/RainJoe/mim/internal/logic/dao"
+	"github.com/RainJoe/mim/internal/registry"
 	pb "github.com/RainJoe/mim/pb/logic"
+	rs "github.com/RainJoe/mim/pkg/redis"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -24,17 +35,64 @@ func main() {
 	if err := d.PingRedis(); err != nil {
 		log.Fatal(err)
 	}
-	lis, err := net.Listen("tcp", ":8090")
+	lis, err := net.Listen("tcp", conf.LogicServer.Addr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	s := grpc.NewServer()
+	verifier, err := auth.New(&conf.Auth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pool := rs.NewRedisPool(&conf.Redis)
+	defer pool.Close()
+	gates := registry.New(pool, "gate", 0).Watch(time.Duration(conf.LogicServer.RegistryReload) * time.Second)
+	defer gates.Close()
+	push := logic.NewPushClients(time.Duration(conf.LogicServer.PushIdleTimeout)*time.Second, gates)
+	defer push.Close()
+	expvar.Publish("push_clients", expvar.Func(func() interface{} { return push.Stats() }))
+	delivery := logic.NewDeliveryTracker(d, time.Duration(conf.LogicServer.AckTimeout)*time.Second, conf.LogicServer.MaxRetries)
+	defer delivery.Close()
+	expvar.Publish("delivery", expvar.Func(func() interface{} { return delivery.Stats() }))
+	if conf.LogicServer.DebugAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(conf.LogicServer.DebugAddr, nil); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
 	svc := &logic.Service{
-		Conf: conf,
-		Dao: d,
+		Conf:     conf,
+		Dao:      d,
+		Verifier: verifier,
+		Push:     push,
+		Gates:    gates,
+		Delivery: delivery,
 	}
+	go svc.SweepPresence()
 	pb.RegisterLogicServiceServer(s, svc)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	// gates only send requests to logic servers that are serving
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(s, hs)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+	var lease *registry.Lease
+	if conf.LogicServer.RegistryTTL > 0 {
+		logics := registry.New(pool, "logic", time.Duration(conf.LogicServer.RegistryTTL)*time.Second)
+		lease = logics.Keepalive(conf.LogicServer.ServerID(), conf.LogicServer.Advertise())
+		log.Infof("logic %s registered with address %s", conf.LogicServer.ServerID(), conf.LogicServer.Advertise())
+	}
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	<-sig
+	// leave the registry and fail the health checks so gates move to other
+	// logic servers, then let the requests in flight finish
+	if lease != nil {
+		lease.Stop()
 	}
+	hs.Shutdown()
+	s.GracefulStop()
 }
@@ -9,15 +9,20 @@ DROP TABLE IF EXISTS im_group;
 CREATE TABLE im_group (
     id SERIAL8 PRIMARY KEY NOT NULL,
     group_id VARCHAR(50) UNIQUE NOT NULL,
-    group_name VARCHAR(50) NOT NULL DEFAULT ''
+    group_name VARCHAR(50) NOT NULL DEFAULT '',
+    muted BOOLEAN NOT NULL DEFAULT FALSE, -- 禁言后只有管理员和群主可以发言
+    open BOOLEAN NOT NULL DEFAULT FALSE -- 开放的群非成员可以直接加入，否则只能由成员邀请
 );
 
 DROP TABLE IF EXISTS im_user_group;
 CREATE TABLE im_user_group (
     id SERIAL8 PRIMARY KEY NOT NULL,
     u_id VARCHAR(50) NOT NULL,
-    group_id VARCHAR(50) NOT NULL
+    group_id VARCHAR(50) NOT NULL,
+    role SMALLINT NOT NULL DEFAULT 0 -- 0普通成员 1管理员 2群主
 );
+CREATE UNIQUE INDEX im_user_group_member ON im_user_group (group_id, u_id);
+CREATE INDEX im_user_group_u_id ON im_user_group (u_id);
 
 DROP TABLE IF EXISTS im_message_send;
 CREATE TABLE im_message_send (
@@ -25,10 +30,48 @@ CREATE TABLE im_message_send (
     msg_from VARCHAR(50),
     msg_to VARCHAR(50),
     msg_seq BIGINT,
-    msg_content VARCHAR(255),
+    msg_content TEXT, -- 群系统通知会超过255个字符
     send_time BIGINT,
-    msg_type SMALLINT
+    msg_type SMALLINT,
+    client_msg_id VARCHAR(64) NOT NULL DEFAULT '',
+    conv_id VARCHAR(110) NOT NULL DEFAULT '',
+    conv_seq BIGINT NOT NULL DEFAULT 0,
+    recalled BOOLEAN NOT NULL DEFAULT FALSE, -- 已撤回，拉取时不返回内容
+    content_type SMALLINT NOT NULL DEFAULT 0, -- 0文本 1图片 2文件 3位置 4自定义
+    payload JSONB NOT NULL DEFAULT '{}' -- json格式的Payload，msg_content保存它的文本摘要
 );
+-- 按会话序列号补齐和翻页查询历史消息都使用这个索引
+CREATE UNIQUE INDEX im_message_send_conv ON im_message_send (conv_id, conv_seq) WHERE conv_id <> '';
+-- 客户端重发的消息按(发送者, client_msg_id)去重
+CREATE UNIQUE INDEX im_message_send_client_msg_id ON im_message_send (msg_from, client_msg_id) WHERE client_msg_id <> '';
+
+-- 每个会话(单聊双方或群)当前分配到的序列号
+DROP TABLE IF EXISTS im_conversation_seq;
+CREATE TABLE im_conversation_seq (
+    conv_id VARCHAR(110) PRIMARY KEY NOT NULL,
+    seq BIGINT NOT NULL DEFAULT 0
+);
+
+-- 会话列表，每个用户的每个单聊或群一行，发消息时和会话序列号在同一个事务中更新
+DROP TABLE IF EXISTS im_conversation;
+CREATE TABLE im_conversation (
+    id SERIAL8 PRIMARY KEY NOT NULL,
+    u_id VARCHAR(50) NOT NULL,
+    conv_id VARCHAR(110) NOT NULL,
+    peer VARCHAR(50) NOT NULL DEFAULT '', -- 单聊的对方
+    group_id VARCHAR(50) NOT NULL DEFAULT '', -- 群
+    last_msg_id BIGINT NOT NULL DEFAULT 0,
+    last_from VARCHAR(50) NOT NULL DEFAULT '',
+    last_content VARCHAR(255) NOT NULL DEFAULT '', -- 最后一条消息的预览
+    last_msg_type SMALLINT NOT NULL DEFAULT 0,
+    last_recalled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_seq BIGINT NOT NULL DEFAULT 0,
+    last_time BIGINT NOT NULL DEFAULT 0,
+    unread INT NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX im_conversation_user ON im_conversation (u_id, conv_id);
+CREATE INDEX im_conversation_list ON im_conversation (u_id, last_time DESC, id DESC);
+CREATE INDEX im_conversation_conv_id ON im_conversation (conv_id);
 
 DROP TABLE IF EXISTS im_message_receive;
 CREATE TABLE im_message_receive (
@@ -36,6 +79,9 @@ CREATE TABLE im_message_receive (
     msg_from VARCHAR(50),
     msg_to VARCHAR(50),
     msg_id BIGINT,
-    flag SMALLINT DEFAULT 0
+    delivered_at BIGINT NOT NULL DEFAULT 0, -- 送达时间(推送确认或拉取)，0表示未送达
+    read_at BIGINT NOT NULL DEFAULT 0 -- 已读时间，0表示未读
 );
+CREATE INDEX im_message_receive_msg_to ON im_message_receive (msg_to, msg_id);
+CREATE INDEX im_message_receive_msg_id ON im_message_receive (msg_id);
 
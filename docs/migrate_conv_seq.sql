@@ -0,0 +1,19 @@
+-- 已有的数据库升级到按会话分配序列号，新建的数据库直接使用db.sql
+ALTER TABLE im_message_send ADD COLUMN conv_id VARCHAR(110) NOT NULL DEFAULT '';
+ALTER TABLE im_message_send ADD COLUMN conv_seq BIGINT NOT NULL DEFAULT 0;
+-- 单聊的会话id为c2c:<较小uid的字节数>:<较小uid>:<较大uid>，uid按字节比较，和model.C2CConversation一致
+UPDATE im_message_send SET conv_id = 'c2c:' || octet_length(LEAST(msg_from COLLATE "C", msg_to COLLATE "C")) || ':'
+    || LEAST(msg_from COLLATE "C", msg_to COLLATE "C") || ':' || GREATEST(msg_from COLLATE "C", msg_to COLLATE "C")
+    WHERE msg_type = 0;
+UPDATE im_message_send SET conv_id = 'group:' || msg_to WHERE msg_type = 1;
+-- 旧消息按msg_id的顺序编号
+UPDATE im_message_send AS m SET conv_seq = s.seq
+    FROM (SELECT msg_id, row_number() OVER (PARTITION BY conv_id ORDER BY msg_id) AS seq FROM im_message_send) AS s
+    WHERE m.msg_id = s.msg_id;
+CREATE UNIQUE INDEX im_message_send_conv ON im_message_send (conv_id, conv_seq) WHERE conv_id <> '';
+
+CREATE TABLE im_conversation_seq (
+    conv_id VARCHAR(110) PRIMARY KEY NOT NULL,
+    seq BIGINT NOT NULL DEFAULT 0
+);
+INSERT INTO im_conversation_seq (conv_id, seq) SELECT conv_id, max(conv_seq) FROM im_message_send GROUP BY conv_id;
@@ -0,0 +1,35 @@
+-- 已有的数据库升级到会话列表，新建的数据库直接使用db.sql
+-- 用到了撤回标记和已读时间，需要先执行migrate_recall.sql和migrate_delivery.sql
+CREATE TABLE im_conversation (
+    id SERIAL8 PRIMARY KEY NOT NULL,
+    u_id VARCHAR(50) NOT NULL,
+    conv_id VARCHAR(110) NOT NULL,
+    peer VARCHAR(50) NOT NULL DEFAULT '', -- 单聊的对方
+    group_id VARCHAR(50) NOT NULL DEFAULT '', -- 群
+    last_msg_id BIGINT NOT NULL DEFAULT 0,
+    last_from VARCHAR(50) NOT NULL DEFAULT '',
+    last_content VARCHAR(255) NOT NULL DEFAULT '', -- 最后一条消息的预览
+    last_msg_type SMALLINT NOT NULL DEFAULT 0,
+    last_recalled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_seq BIGINT NOT NULL DEFAULT 0,
+    last_time BIGINT NOT NULL DEFAULT 0,
+    unread INT NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX im_conversation_user ON im_conversation (u_id, conv_id);
+CREATE INDEX im_conversation_list ON im_conversation (u_id, last_time DESC, id DESC);
+CREATE INDEX im_conversation_conv_id ON im_conversation (conv_id);
+-- 单聊双方和群成员各有一行，最后一条消息取会话序列号最大的消息，未读数为还没有读的消息数
+WITH members AS (
+    SELECT msg_from AS u_id, conv_id, msg_to AS peer, '' AS group_id FROM im_message_send WHERE msg_type = 0
+    UNION
+    SELECT msg_to, conv_id, msg_from, '' FROM im_message_send WHERE msg_type = 0
+    UNION
+    SELECT u_id, 'group:' || group_id, '', group_id FROM im_user_group
+), last AS (
+    SELECT DISTINCT ON (conv_id) * FROM im_message_send WHERE conv_id <> '' ORDER BY conv_id, conv_seq DESC
+)
+INSERT INTO im_conversation (u_id, conv_id, peer, group_id, last_msg_id, last_from, last_content, last_msg_type, last_recalled, last_seq, last_time, unread)
+SELECT m.u_id, m.conv_id, m.peer, m.group_id, l.msg_id, l.msg_from, left(coalesce(l.msg_content, ''), 50), l.msg_type, l.recalled, l.conv_seq, l.send_time,
+    (SELECT count(*) FROM im_message_receive AS r JOIN im_message_send AS s ON r.msg_id = s.msg_id
+        WHERE r.msg_to = m.u_id AND s.conv_id = m.conv_id AND r.read_at = 0)
+FROM members AS m JOIN last AS l ON l.conv_id = m.conv_id;
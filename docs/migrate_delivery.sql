@@ -0,0 +1,9 @@
+-- 已有的数据库升级到分开记录送达和已读，新建的数据库直接使用db.sql
+ALTER TABLE im_message_receive ADD COLUMN delivered_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE im_message_receive ADD COLUMN read_at BIGINT NOT NULL DEFAULT 0;
+-- flag为1的消息已经送达，没有记录送达时间，使用消息的发送时间
+UPDATE im_message_receive AS r SET delivered_at = s.send_time
+    FROM im_message_send AS s WHERE r.msg_id = s.msg_id AND r.flag = 1;
+ALTER TABLE im_message_receive DROP COLUMN flag;
+CREATE INDEX IF NOT EXISTS im_message_receive_msg_to ON im_message_receive (msg_to, msg_id);
+CREATE INDEX IF NOT EXISTS im_message_receive_msg_id ON im_message_receive (msg_id);
@@ -0,0 +1,9 @@
+-- 已有的数据库升级到群管理，新建的数据库直接使用db.sql
+-- 同一用户重复加入的记录只保留一条
+DELETE FROM im_user_group AS a USING im_user_group AS b
+    WHERE a.group_id = b.group_id AND a.u_id = b.u_id AND a.id > b.id;
+CREATE UNIQUE INDEX im_user_group_member ON im_user_group (group_id, u_id);
+CREATE INDEX im_user_group_u_id ON im_user_group (u_id);
+ALTER TABLE im_group ADD COLUMN open BOOLEAN NOT NULL DEFAULT FALSE;
+-- 群系统通知会超过255个字符
+ALTER TABLE im_message_send ALTER COLUMN msg_content TYPE TEXT;
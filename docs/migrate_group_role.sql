@@ -0,0 +1,5 @@
+-- 已有的数据库升级到群角色，新建的数据库直接使用db.sql
+ALTER TABLE im_group ADD COLUMN muted BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE im_user_group ADD COLUMN role SMALLINT NOT NULL DEFAULT 0;
+-- 旧的群没有记录群主，最早加入的成员成为群主
+UPDATE im_user_group SET role = 2 WHERE id IN (SELECT min(id) FROM im_user_group GROUP BY group_id);
@@ -0,0 +1,7 @@
+-- 已有的数据库升级到结构化消息内容，新建的数据库直接使用db.sql
+ALTER TABLE im_message_send ALTER COLUMN msg_content TYPE TEXT;
+ALTER TABLE im_message_send ADD COLUMN content_type SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE im_message_send ADD COLUMN payload JSONB NOT NULL DEFAULT '{}';
+-- 旧消息都是文本消息，群系统通知(msg_type为2)没有payload
+UPDATE im_message_send SET payload = jsonb_build_object('text', jsonb_build_object('text', msg_content))
+    WHERE msg_type <> 2 AND msg_content IS NOT NULL;
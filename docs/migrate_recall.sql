@@ -0,0 +1,2 @@
+-- 已有的数据库升级到消息撤回，新建的数据库直接使用db.sql
+ALTER TABLE im_message_send ADD COLUMN recalled BOOLEAN NOT NULL DEFAULT FALSE;
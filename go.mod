@@ -5,9 +5,11 @@ go 1.13
 require (
 	github.com/BurntSushi/toml v0.3.1
 	github.com/garyburd/redigo v1.6.0
+	github.com/golang-jwt/jwt/v4 v4.5.2
 	github.com/golang/protobuf v1.3.3
 	github.com/gorilla/websocket v1.4.1
 	github.com/jmoiron/sqlx v1.2.0
+	github.com/lib/pq v1.0.0
 	go.uber.org/atomic v1.5.1 // indirect
 	go.uber.org/multierr v1.4.0 // indirect
 	go.uber.org/zap v1.13.0
@@ -0,0 +1,14 @@
+// Package blobid defines the ids of the blobs uploaded to the gate, shared by
+// the gate storing them and the logic service checking the messages that
+// reference them.
+package blobid
+
+import "regexp"
+
+var pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Valid reports whether id is a well formed blob id, the hex sha256 of the
+// content
+func Valid(id string) bool {
+	return pattern.MatchString(id)
+}
@@ -0,0 +1,84 @@
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrNotFound the blob or upload does not exist
+	ErrNotFound = errors.New("blob not found")
+	// ErrTooLarge the file is larger than MaxFileSize
+	ErrTooLarge = errors.New("file too large")
+	// ErrQuotaExceeded the upload would exceed the quota of the user
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrBadChecksum the content does not match the checksum of the client
+	ErrBadChecksum = errors.New("checksum mismatch")
+	// ErrBadOffset the chunk does not continue the upload
+	ErrBadOffset = errors.New("chunk offset mismatch")
+	// ErrIncomplete the upload is committed before all bytes were written
+	ErrIncomplete = errors.New("upload incomplete")
+)
+
+// Info describes a stored blob
+type Info struct {
+	// ID is the hex sha256 of the content
+	ID       string `json:"id"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mime_type"`
+}
+
+// Blob is the content of a stored blob
+type Blob interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// Store keeps blobs addressed by the sha256 of their content. Files are
+// uploaded in chunks written in order, a committed upload becomes a blob whose
+// id can be referenced from messages.
+type Store interface {
+	// Begin starts an upload of size bytes by the owner and returns its id
+	Begin(owner string, size int64, mimeType string) (uploadID string, err error)
+	// WriteChunk appends the chunk to the upload, offset must be the number
+	// of bytes received so far, which is returned
+	WriteChunk(owner string, uploadID string, offset int64, data []byte) (received int64, err error)
+	// Commit checks the content against the hex sha256 checksum and stores
+	// it as a blob
+	Commit(owner string, uploadID string, checksum string) (*Info, error)
+	// Abort discards the upload
+	Abort(owner string, uploadID string) error
+	// Stat returns the info of the blob
+	Stat(id string) (*Info, error)
+	// Open returns the content of the blob
+	Open(id string) (Blob, *Info, error)
+}
+
+// Config def
+type Config struct {
+	// Store is "local", the blob store is disabled when empty
+	Store string
+	// Dir is the directory of the local store
+	Dir string
+	// MaxFileSize in bytes of a single file
+	MaxFileSize int64
+	// UserQuota in bytes a user may upload in total, 0 means no limit
+	UserQuota int64
+	// ChunkSize in bytes clients should upload and download at once
+	ChunkSize int
+	// UploadTimeout in seconds, uploads not committed in this time are
+	// discarded
+	UploadTimeout int
+}
+
+// New store from config, nil if the store is disabled
+func New(c *Config) (Store, error) {
+	switch c.Store {
+	case "":
+		return nil, nil
+	case "local":
+		return NewLocalStore(c)
+	}
+	return nil, fmt.Errorf("unknown blob store %q", c.Store)
+}
@@ -0,0 +1,277 @@
+package blob
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RainJoe/mim/internal/blobid"
+)
+
+type upload struct {
+	owner    string
+	size     int64
+	mimeType string
+	received int64
+	created  time.Time
+}
+
+// LocalStore keeps blobs on the local filesystem. Blobs are stored under
+// blobs/ by the first two characters of their id with a json file holding
+// their info, uploads are written to uploads/ until committed. The bytes
+// uploaded by each user are counted in usage.json.
+type LocalStore struct {
+	dir     string
+	conf    *Config
+	mu      sync.Mutex
+	uploads map[string]*upload
+	usage   map[string]int64
+}
+
+// NewLocalStore creates the directories of the store and loads the usage of
+// the users.
+func NewLocalStore(c *Config) (*LocalStore, error) {
+	s := &LocalStore{
+		dir:     c.Dir,
+		conf:    c,
+		uploads: make(map[string]*upload),
+		usage:   make(map[string]int64),
+	}
+	// uploads can not be resumed after a restart
+	if err := os.RemoveAll(filepath.Join(c.Dir, "uploads")); err != nil {
+		return nil, err
+	}
+	for _, d := range []string{"blobs", "uploads"} {
+		if err := os.MkdirAll(filepath.Join(c.Dir, d), 0755); err != nil {
+			return nil, err
+		}
+	}
+	data, err := ioutil.ReadFile(s.usagePath())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &s.usage); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *LocalStore) usagePath() string {
+	return filepath.Join(s.dir, "usage.json")
+}
+
+func (s *LocalStore) uploadPath(uploadID string) string {
+	return filepath.Join(s.dir, "uploads", uploadID)
+}
+
+func (s *LocalStore) blobPath(id string) string {
+	return filepath.Join(s.dir, "blobs", id[:2], id)
+}
+
+// expire discards the uploads older than the upload timeout, it is called
+// with the lock held
+func (s *LocalStore) expire() {
+	if s.conf.UploadTimeout <= 0 {
+		return
+	}
+	deadline := time.Now().Add(-time.Duration(s.conf.UploadTimeout) * time.Second)
+	for id, u := range s.uploads {
+		if u.created.Before(deadline) {
+			delete(s.uploads, id)
+			os.Remove(s.uploadPath(id))
+		}
+	}
+}
+
+func (s *LocalStore) Begin(owner string, size int64, mimeType string) (string, error) {
+	if size <= 0 || (s.conf.MaxFileSize > 0 && size > s.conf.MaxFileSize) {
+		return "", ErrTooLarge
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	uploadID := hex.EncodeToString(b)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+	if s.conf.UserQuota > 0 {
+		pending := s.usage[owner]
+		for _, u := range s.uploads {
+			if u.owner == owner {
+				pending += u.size
+			}
+		}
+		if pending+size > s.conf.UserQuota {
+			return "", ErrQuotaExceeded
+		}
+	}
+	f, err := os.Create(s.uploadPath(uploadID))
+	if err != nil {
+		return "", err
+	}
+	f.Close()
+	s.uploads[uploadID] = &upload{owner: owner, size: size, mimeType: mimeType, created: time.Now()}
+	return uploadID, nil
+}
+
+// lookup returns the upload of the owner, it is called with the lock held
+func (s *LocalStore) lookup(owner string, uploadID string) (*upload, error) {
+	u, ok := s.uploads[uploadID]
+	if !ok || u.owner != owner {
+		return nil, ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *LocalStore) WriteChunk(owner string, uploadID string, offset int64, data []byte) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, err := s.lookup(owner, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	if offset != u.received {
+		return u.received, ErrBadOffset
+	}
+	if u.received+int64(len(data)) > u.size {
+		return u.received, ErrTooLarge
+	}
+	f, err := os.OpenFile(s.uploadPath(uploadID), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return u.received, err
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return u.received, err
+	}
+	u.received += int64(len(data))
+	return u.received, nil
+}
+
+func (s *LocalStore) Commit(owner string, uploadID string, checksum string) (*Info, error) {
+	s.mu.Lock()
+	u, err := s.lookup(owner, uploadID)
+	if err == nil && u.received != u.size {
+		err = ErrIncomplete
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	// the upload can not be written to or committed again while hashing
+	delete(s.uploads, uploadID)
+	s.mu.Unlock()
+
+	path := s.uploadPath(uploadID)
+	defer os.Remove(path)
+	id, err := hashFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if id != strings.ToLower(checksum) {
+		return nil, ErrBadChecksum
+	}
+	info := &Info{ID: id, Size: u.size, MimeType: u.mimeType}
+	if err := s.save(path, info); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.usage[owner] += u.size
+	data, err := json.Marshal(s.usage)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(s.usagePath(), data, 0644); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// save moves the uploaded file to the blob path, a blob with the same
+// content that already exists is kept. The info is written last, a blob
+// without info is not visible.
+func (s *LocalStore) save(path string, info *Info) error {
+	dst := s.blobPath(info.ID)
+	if _, err := os.Stat(dst + ".json"); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(path, dst); err != nil {
+		return err
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst+".json", data, 0644)
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (s *LocalStore) Abort(owner string, uploadID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.lookup(owner, uploadID); err != nil {
+		return err
+	}
+	delete(s.uploads, uploadID)
+	return os.Remove(s.uploadPath(uploadID))
+}
+
+func (s *LocalStore) Stat(id string) (*Info, error) {
+	if !blobid.Valid(id) {
+		return nil, ErrNotFound
+	}
+	data, err := ioutil.ReadFile(s.blobPath(id) + ".json")
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var info Info
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (s *LocalStore) Open(id string) (Blob, *Info, error) {
+	info, err := s.Stat(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(s.blobPath(id))
+	if os.IsNotExist(err) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, info, nil
+}
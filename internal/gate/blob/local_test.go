@@ -0,0 +1,198 @@
+package blob
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T, c Config) (*LocalStore, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Store = "local"
+	c.Dir = dir
+	s, err := NewLocalStore(&c)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return s, func() { os.RemoveAll(dir) }
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// uploadData uploads data in chunks of n bytes and commits it.
+func uploadData(t *testing.T, s *LocalStore, owner string, data []byte, n int) *Info {
+	t.Helper()
+	id, err := s.Begin(owner, int64(len(data)), "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for off := 0; off < len(data); off += n {
+		end := off + n
+		if end > len(data) {
+			end = len(data)
+		}
+		received, err := s.WriteChunk(owner, id, int64(off), data[off:end])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if received != int64(end) {
+			t.Fatalf("received %d, want %d", received, end)
+		}
+	}
+	info, err := s.Commit(owner, id, checksum(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func TestLocalStoreUpload(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{})
+	defer cleanup()
+	data := []byte("hello blob store")
+	info := uploadData(t, s, "alice", data, 5)
+	if info.ID != checksum(data) || info.Size != int64(len(data)) || info.MimeType != "text/plain" {
+		t.Fatalf("unexpected info %+v", info)
+	}
+	b, stat, err := s.Open(info.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	got, err := ioutil.ReadAll(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(data) || *stat != *info {
+		t.Errorf("opened %q %+v, want %q %+v", got, stat, data, info)
+	}
+	// the same content uploaded again is stored once
+	if again := uploadData(t, s, "bob", data, 100); again.ID != info.ID {
+		t.Errorf("same content stored as %s and %s", info.ID, again.ID)
+	}
+}
+
+func TestLocalStoreChunks(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{})
+	defer cleanup()
+	id, err := s.Begin("alice", 4, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.WriteChunk("bob", id, 0, []byte("ab")); err != ErrNotFound {
+		t.Errorf("chunk of another owner returned %v, want ErrNotFound", err)
+	}
+	if received, err := s.WriteChunk("alice", id, 0, []byte("ab")); err != nil || received != 2 {
+		t.Fatalf("WriteChunk() = %d, %v", received, err)
+	}
+	if received, err := s.WriteChunk("alice", id, 1, []byte("bc")); err != ErrBadOffset || received != 2 {
+		t.Errorf("overlapping chunk returned %d, %v, want 2, ErrBadOffset", received, err)
+	}
+	if _, err := s.WriteChunk("alice", id, 2, []byte("cde")); err != ErrTooLarge {
+		t.Errorf("chunk past the size returned %v, want ErrTooLarge", err)
+	}
+	if _, err := s.Commit("alice", id, checksum([]byte("ab"))); err != ErrIncomplete {
+		t.Errorf("incomplete commit returned %v, want ErrIncomplete", err)
+	}
+	if _, err := s.WriteChunk("alice", id, 2, []byte("cd")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Commit("alice", id, checksum([]byte("abce"))); err != ErrBadChecksum {
+		t.Errorf("commit with a wrong checksum returned %v, want ErrBadChecksum", err)
+	}
+	// a failed commit discards the upload
+	if _, err := s.Commit("alice", id, checksum([]byte("abcd"))); err != ErrNotFound {
+		t.Errorf("commit of a discarded upload returned %v, want ErrNotFound", err)
+	}
+	if _, err := s.Stat(checksum([]byte("abce"))); err != ErrNotFound {
+		t.Errorf("content with a wrong checksum stored")
+	}
+}
+
+func TestLocalStoreLimits(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{MaxFileSize: 10, UserQuota: 16})
+	defer cleanup()
+	if _, err := s.Begin("alice", 11, ""); err != ErrTooLarge {
+		t.Errorf("file above MaxFileSize returned %v, want ErrTooLarge", err)
+	}
+	if _, err := s.Begin("alice", 0, ""); err != ErrTooLarge {
+		t.Errorf("empty file returned %v, want ErrTooLarge", err)
+	}
+	uploadData(t, s, "alice", []byte("0123456789"), 4)
+	pending, err := s.Begin("alice", 6, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// pending uploads count against the quota
+	if _, err := s.Begin("alice", 1, ""); err != ErrQuotaExceeded {
+		t.Errorf("upload above UserQuota returned %v, want ErrQuotaExceeded", err)
+	}
+	if _, err := s.Begin("bob", 10, ""); err != nil {
+		t.Errorf("quota of another user applied: %v", err)
+	}
+	if err := s.Abort("alice", pending); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Begin("alice", 6, ""); err != nil {
+		t.Errorf("aborted upload still counted: %v", err)
+	}
+}
+
+func TestLocalStoreUsagePersisted(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{UserQuota: 10})
+	defer cleanup()
+	info := uploadData(t, s, "alice", []byte("01234567"), 8)
+	reopened, err := NewLocalStore(s.conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reopened.Begin("alice", 3, ""); err != ErrQuotaExceeded {
+		t.Errorf("usage lost on restart, Begin() returned %v", err)
+	}
+	if _, err := reopened.Stat(info.ID); err != nil {
+		t.Errorf("blob lost on restart: %v", err)
+	}
+}
+
+func TestLocalStoreUploadTimeout(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{UploadTimeout: 1})
+	defer cleanup()
+	id, err := s.Begin("alice", 4, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	s.uploads[id].created = time.Now().Add(-2 * time.Second)
+	s.mu.Unlock()
+	// expired uploads are discarded when the next one begins
+	if _, err := s.Begin("alice", 4, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.WriteChunk("alice", id, 0, []byte("abcd")); err != ErrNotFound {
+		t.Errorf("chunk of an expired upload returned %v, want ErrNotFound", err)
+	}
+}
+
+func TestLocalStoreStat(t *testing.T) {
+	s, cleanup := newTestStore(t, Config{})
+	defer cleanup()
+	for _, id := range []string{"", "../usage.json", checksum([]byte("missing"))} {
+		if _, err := s.Stat(id); err != ErrNotFound {
+			t.Errorf("Stat(%q) returned %v, want ErrNotFound", id, err)
+		}
+		if _, _, err := s.Open(id); err != ErrNotFound {
+			t.Errorf("Open(%q) returned %v, want ErrNotFound", id, err)
+		}
+	}
+}
@@ -3,13 +3,18 @@ package gate
 import (
 	"bytes"
 	"context"
-	"github.com/golang/protobuf/proto"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
+	"github.com/RainJoe/mim/internal/gate/config"
 	pb "github.com/RainJoe/mim/pb/logic"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	"github.com/RainJoe/mim/protocol"
-	"github.com/gorilla/websocket"
 )
 
 const (
@@ -23,50 +28,224 @@ const (
 	pingPeriod = (pongWait * 9) / 10
 
 	// Maximum message size allowed from peer.
-	maxMessageSize = 512
+	maxMessageSize = 64 * 1024
+)
+
+var (
+	errUnauthenticated      = errors.New("packet on unauthenticated connection dropped")
+	errAlreadyAuthenticated = errors.New("connection already authenticated")
+	errClientClosed         = errors.New("send to closed connection")
+	errSlowConsumer         = errors.New("send queue of slow consumer full")
 )
 
-// Client is a middleman between the websocket connection and the hub.
+// pullRequiredPacket tells a client whose pushes were skipped to pull its
+// offline messages.
+var pullRequiredPacket, _ = (&protocol.Packet{
+	Header: protocol.NewHeader(protocol.V1, protocol.PullRequiredPushMessage),
+}).Pack()
+
+// defaultSendQueue is the number of outbound packets queued per connection
+// when not configured.
+const defaultSendQueue = 256
+
+// sendOptions configure the outbound queue of a connection.
+type sendOptions struct {
+	// Number of packets queued for the writer.
+	queue int
+	// One of the config.SlowConsumer policies, applied to pushes that find
+	// the queue full.
+	slowConsumer string
+}
+
+// Conn is a packet oriented connection between a client and the gate.
+// Each message read from or written to a Conn is a single packed
+// protocol.Packet.
+type Conn interface {
+	// ReadMessage reads the next packet from the peer. It returns io.EOF
+	// when the peer closed the connection normally.
+	ReadMessage() ([]byte, error)
+	// WriteMessage writes a packet to the peer.
+	WriteMessage(data []byte) error
+	// Ping keeps the connection alive while there is nothing to write.
+	Ping() error
+	// Close closes the connection.
+	Close() error
+}
+
+// Client is a middleman between the client connection and the hub.
 type Client struct {
-	// The websocket connection.
-	conn *websocket.Conn
+	// The client connection.
+	conn Conn
 
 	// Buffered channel of outbound messages.
 	send chan []byte
 
+	// Closed when the client is removed, the writer exits and sends fail
+	// afterwards.
+	done      chan struct{}
+	closeOnce sync.Once
+
+	slowConsumer string
+
+	// Set when pushes were skipped with config.SlowConsumerOffline, the
+	// writer tells the client to pull once it caught up.
+	spilled int32
+
 	hub *Hub
 
+	// Guards the authentication state, uid, deviceID and platform are set
+	// once together with authed and never change afterwards.
+	mu sync.Mutex
+
+	authed bool
+
+	// Set when the connection is torn down, the client can not be
+	// authenticated or registered afterwards.
+	closed bool
+
 	uid string
 
+	deviceID string
+
+	platform string
+
 	logicService pb.LogicServiceClient
+
+	blobs *BlobService
+
+	workers *WorkerPool
+
+	// Requests read from the connection waiting for a worker.
+	inbox chan *protocol.Packet
+
+	// Set while the client is handed to a worker.
+	scheduled int32
+}
+
+func newClient(conn Conn, hub *Hub, logicService pb.LogicServiceClient, blobs *BlobService, workers *WorkerPool, opts sendOptions) *Client {
+	if opts.queue <= 0 {
+		opts.queue = defaultSendQueue
+	}
+	c := &Client{
+		conn:         conn,
+		send:         make(chan []byte, opts.queue),
+		done:         make(chan struct{}),
+		slowConsumer: opts.slowConsumer,
+		logicService: logicService,
+		hub:          hub,
+		blobs:        blobs,
+		workers:      workers,
+	}
+	if workers != nil {
+		c.inbox = make(chan *protocol.Packet, workers.queueSize)
+	}
+	return c
+}
+
+// serve starts the pumps of the client. Allow collection of memory referenced
+// by the caller by doing all work in new goroutines. The connection is closed
+// when it is not authenticated within authTimeout, zero disables the timeout.
+func (c *Client) serve(authTimeout time.Duration) {
+	go c.writePump()
+	go c.readPump()
+	if authTimeout > 0 {
+		time.AfterFunc(authTimeout, func() {
+			if !c.authenticated() {
+				log.Info("closing connection not authenticated in time")
+				c.conn.Close()
+			}
+		})
+	}
+}
+
+func (c *Client) authenticated() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.authed
+}
+
+// authenticate sets the authentication state of the client, it returns false
+// if the client was already authenticated or its connection torn down.
+func (c *Client) authenticate(uid string, deviceID string, platform string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.authed || c.closed {
+		return false
+	}
+	c.authed = true
+	c.uid = uid
+	c.deviceID = deviceID
+	c.platform = platform
+	return true
+}
+
+// userID returns the uid the client authenticated as, empty before.
+func (c *Client) userID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.uid
+}
+
+// identity returns the uid and device the client authenticated as, empty
+// before.
+func (c *Client) identity() (uid string, deviceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.uid, c.deviceID
 }
 
-// readPump pumps messages from the websocket connection to the hub.
+// markClosed marks the connection torn down, it happens before the client is
+// unregistered so that a registration still in flight is dropped.
+func (c *Client) markClosed() {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+}
+
+// isClosed reports whether the connection was torn down.
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// readPump pumps messages from the client connection to the workers.
 //
 // The application runs readPump in a per-connection goroutine. The application
 // ensures that there is at most one reader on a connection by executing all
-// reads from this goroutine.
+// reads from this goroutine. Requests are queued to be processed in order,
+// only heartbeats, which do not depend on other requests, are answered right
+// away.
 func (c *Client) readPump() {
 	defer func() {
 		c.conn.Close()
-		c.hub.unregister <- c
+		c.markClosed()
+		c.hub.unregister(c)
 	}()
-	c.conn.SetReadLimit(maxMessageSize)
-	c.conn.SetReadDeadline(time.Now().Add(pongWait))
-	c.conn.SetPongHandler(func(string) error { c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
 	for {
-		_, message, err := c.conn.ReadMessage()
+		message, err := c.conn.ReadMessage()
 		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+			if err != io.EOF {
 				log.Errorf("error: %v", err)
 			}
 			break
 		}
-		go c.handleRecvMessage(message)
+		p, err := parseMessage(message)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		if p.Header.Cmd == protocol.HeartBeatRequestMessage {
+			if err := c.handleHeartBeat(p); err != nil {
+				log.Error(err)
+			}
+			continue
+		}
+		c.workers.enqueue(c, p)
 	}
 }
 
-// writePump pumps messages from the hub to the websocket connection.
+// writePump pumps messages from the hub to the client connection.
 //
 // A goroutine running writePump is started for each connection. The
 // application ensures that there is at most one writer to a connection by
@@ -79,32 +258,35 @@ func (c *Client) writePump() {
 	}()
 	for {
 		select {
-		case message, ok := <-c.send:
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				// The hub closed the channel.
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+		case message := <-c.send:
+			if err := c.conn.WriteMessage(message); err != nil {
 				return
 			}
-
-			w, err := c.conn.NextWriter(websocket.BinaryMessage)
-			if err != nil {
-				return
+			if len(c.send) == 0 && atomic.CompareAndSwapInt32(&c.spilled, 1, 0) {
+				if err := c.conn.WriteMessage(pullRequiredPacket); err != nil {
+					return
+				}
 			}
-			w.Write(message)
-
-			if err := w.Close(); err != nil {
-				return
+		case <-c.done:
+			// The hub removed the client, write what was queued before,
+			// such as the kick out notice.
+			for {
+				select {
+				case message := <-c.send:
+					if err := c.conn.WriteMessage(message); err != nil {
+						return
+					}
+				default:
+					return
+				}
 			}
 		case <-ticker.C:
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			if err := c.conn.Ping(); err != nil {
 				return
 			}
 		}
 	}
 }
-
 func parseMessage(message []byte) (*protocol.Packet, error) {
 	r := bytes.NewReader(message)
 	p := protocol.Packet{}
@@ -114,8 +296,71 @@ func parseMessage(message []byte) (*protocol.Packet, error) {
 	return &p, nil
 }
 
-func (c *Client) sendMessage2Write(b []byte) {
-	c.send <- b
+// close stops the writer, it is called by the hub when the client is
+// removed.
+func (c *Client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// sendMessage2Write queues the response to a request of the client. It waits
+// up to writeWait for room in the queue, the client is disconnected when it
+// does not read its responses.
+func (c *Client) sendMessage2Write(b []byte) error {
+	select {
+	case c.send <- b:
+		return nil
+	case <-c.done:
+		return errClientClosed
+	default:
+	}
+	timer := time.NewTimer(writeWait)
+	defer timer.Stop()
+	select {
+	case c.send <- b:
+		return nil
+	case <-c.done:
+		return errClientClosed
+	case <-timer.C:
+		uid, deviceID := c.identity()
+		log.Infof("disconnecting %s %s not reading its responses", uid, deviceID)
+		c.conn.Close()
+		return errSlowConsumer
+	}
+}
+
+// push queues a push without blocking, when the queue is full the slow
+// consumer policy of the client decides what happens to it.
+func (c *Client) push(b []byte) error {
+	select {
+	case <-c.done:
+		return errClientClosed
+	default:
+	}
+	select {
+	case c.send <- b:
+		return nil
+	default:
+	}
+	switch c.slowConsumer {
+	case config.SlowConsumerDisconnect:
+		uid, deviceID := c.identity()
+		log.Infof("disconnecting slow consumer %s %s", uid, deviceID)
+		c.conn.Close()
+		return errSlowConsumer
+	case config.SlowConsumerOffline:
+		// the messages stay undelivered in the offline storage, the
+		// client pulls them when it is told to
+		if atomic.CompareAndSwapInt32(&c.spilled, 0, 1) {
+			uid, deviceID := c.identity()
+			log.Infof("slow consumer %s %s left to pull its messages", uid, deviceID)
+		}
+		return nil
+	}
+	// receipts and presence changes are lost, messages are pushed again
+	// when they are not acked in time
+	return nil
 }
 
 func (c *Client) handleHeartBeat(p *protocol.Packet) error {
@@ -127,19 +372,32 @@ func (c *Client) handleHeartBeat(p *protocol.Packet) error {
 	if err != nil {
 		return err
 	}
-	c.sendMessage2Write(b)
-	return nil
+	return c.sendMessage2Write(b)
 }
 
 func (c *Client) handleAuth(p *protocol.Packet) error {
+	if c.authenticated() {
+		return errAlreadyAuthenticated
+	}
 	req := pb.AuthRequest{}
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.GateId = c.hub.id
 	rsp, err := c.logicService.Auth(context.TODO(), &req)
 	if err != nil {
 		return err
 	}
+	// register before answering, the client may send right after the response
+	if rsp.Status == 0 {
+		if !c.authenticate(req.Uid, req.DeviceId, req.Platform) {
+			if c.isClosed() {
+				return errClientClosed
+			}
+			return errAlreadyAuthenticated
+		}
+		c.hub.register(c)
+	}
 	sendPacket, err := protocol.NewPacket(protocol.V1, protocol.AuthResponseMessage, rsp)
 	if err != nil {
 		return err
@@ -147,10 +405,6 @@ func (c *Client) handleAuth(p *protocol.Packet) error {
 	if err := c.sendPacket(sendPacket); err != nil {
 		return err
 	}
-	if rsp.Status == 0 {
-		c.uid = req.Uid
-		c.hub.register <- c
-	}
 	return nil
 }
 
@@ -159,6 +413,7 @@ func (c *Client) handleLogout(p *protocol.Packet) error {
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.Uid = c.userID()
 	rsp, err := c.logicService.Logout(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -178,8 +433,7 @@ func (c *Client) sendPacket(p *protocol.Packet) error {
 	if err != nil {
 		return err
 	}
-	c.sendMessage2Write(msg)
-	return nil
+	return c.sendMessage2Write(msg)
 }
 
 func (c *Client) handleC2CSendRequest(p *protocol.Packet) error {
@@ -187,6 +441,7 @@ func (c *Client) handleC2CSendRequest(p *protocol.Packet) error {
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.From = c.userID()
 	rsp, err := c.logicService.C2CSend(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -206,6 +461,7 @@ func (c *Client) handleC2GSendRequest(p *protocol.Packet) error {
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.From = c.userID()
 	rsp, err := c.logicService.C2GSend(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -225,6 +481,7 @@ func (c *Client) handleC2SPullRequest(p *protocol.Packet) error {
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.Uid = c.userID()
 	rsp, err := c.logicService.C2SPull(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -239,11 +496,123 @@ func (c *Client) handleC2SPullRequest(p *protocol.Packet) error {
 	return nil
 }
 
+func (c *Client) handlePullBySeqRequest(p *protocol.Packet) error {
+	req := pb.PullBySeqRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.PullBySeq(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	sendPacket, err := protocol.NewPacket(protocol.V1, protocol.PullBySeqResponseMessage, rsp)
+	if err != nil {
+		return err
+	}
+	if err := c.sendPacket(sendPacket); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Client) handleRecallRequest(p *protocol.Packet) error {
+	req := pb.RecallRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.Recall(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.RecallResponseMessage, rsp)
+}
+
+func (c *Client) handleMarkReadRequest(p *protocol.Packet) error {
+	req := pb.MarkReadRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.MarkRead(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.MarkReadResponseMessage, rsp)
+}
+
+func (c *Client) handleReadCountsRequest(p *protocol.Packet) error {
+	req := pb.ReadCountsRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.GetReadCounts(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.ReadCountsResponseMessage, rsp)
+}
+
+func (c *Client) handleListConversationsRequest(p *protocol.Packet) error {
+	req := pb.ListConversationsRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.ListConversations(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.ListConversationsResponseMessage, rsp)
+}
+
+func (c *Client) handleClearUnreadRequest(p *protocol.Packet) error {
+	req := pb.ClearUnreadRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.ClearUnread(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.ClearUnreadResponseMessage, rsp)
+}
+
+func (c *Client) handleHistoryRequest(p *protocol.Packet) error {
+	req := pb.HistoryRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.History(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.HistoryResponseMessage, rsp)
+}
+
+func (c *Client) handleSignalRequest(p *protocol.Packet) error {
+	req := pb.SignalRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.From = c.userID()
+	rsp, err := c.logicService.Signal(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.SignalResponseMessage, rsp)
+}
+
 func (c *Client) handleC2CPushResponse(p *protocol.Packet) error {
 	req := pb.C2CPushResponse{}
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.Uid = c.userID()
 	_, err := c.logicService.C2CPushAck(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -256,6 +625,7 @@ func (c *Client) handleC2GPushResponse(p *protocol.Packet) error {
 	if err := proto.Unmarshal(p.Body(), &req); err != nil {
 		return err
 	}
+	req.Uid = c.userID()
 	_, err := c.logicService.C2GPushAck(context.TODO(), &req)
 	if err != nil {
 		return err
@@ -269,6 +639,13 @@ func (c *Client) dispatchPacket(p *protocol.Packet) error {
 		return c.handleHeartBeat(p)
 	case protocol.AuthRequestMessage:
 		return c.handleAuth(p)
+	}
+	// everything else needs an authenticated connection, the handlers
+	// below use the authenticated uid instead of the one in the request
+	if !c.authenticated() {
+		return errUnauthenticated
+	}
+	switch p.Header.Cmd {
 	case protocol.LogoutRequestMessage:
 		return c.handleLogout(p)
 	case protocol.LogoutResponseMessage:
@@ -279,22 +656,61 @@ func (c *Client) dispatchPacket(p *protocol.Packet) error {
 		return c.handleC2GSendRequest(p)
 	case protocol.C2SPullRequestMessage:
 		return c.handleC2SPullRequest(p)
+	case protocol.PullBySeqRequestMessage:
+		return c.handlePullBySeqRequest(p)
 	case protocol.C2CPushResponseMessage:
 		return c.handleC2CPushResponse(p)
 	case protocol.C2GPushResponseMessage:
 		return c.handleC2GPushResponse(p)
+	case protocol.CreateGroupRequestMessage:
+		return c.handleCreateGroupRequest(p)
+	case protocol.RenameGroupRequestMessage:
+		return c.handleRenameGroupRequest(p)
+	case protocol.DisbandGroupRequestMessage,
+		protocol.JoinGroupRequestMessage,
+		protocol.LeaveGroupRequestMessage,
+		protocol.ListGroupMembersRequestMessage:
+		return c.handleGroupRequest(p)
+	case protocol.InviteGroupMembersRequestMessage,
+		protocol.RemoveGroupMembersRequestMessage:
+		return c.handleGroupMembersRequest(p)
+	case protocol.ListUserGroupsRequestMessage:
+		return c.handleListUserGroupsRequest(p)
+	case protocol.MuteGroupRequestMessage:
+		return c.handleMuteGroupRequest(p)
+	case protocol.SetGroupAdminRequestMessage:
+		return c.handleSetGroupAdminRequest(p)
+	case protocol.TransferGroupOwnerRequestMessage:
+		return c.handleTransferGroupOwnerRequest(p)
+	case protocol.RecallRequestMessage:
+		return c.handleRecallRequest(p)
+	case protocol.MarkReadRequestMessage:
+		return c.handleMarkReadRequest(p)
+	case protocol.ReadCountsRequestMessage:
+		return c.handleReadCountsRequest(p)
+	case protocol.ListConversationsRequestMessage:
+		return c.handleListConversationsRequest(p)
+	case protocol.ClearUnreadRequestMessage:
+		return c.handleClearUnreadRequest(p)
+	case protocol.HistoryRequestMessage:
+		return c.handleHistoryRequest(p)
+	case protocol.SignalRequestMessage:
+		return c.handleSignalRequest(p)
+	case protocol.UploadBeginRequestMessage:
+		return c.handleUploadBeginRequest(p)
+	case protocol.UploadChunkRequestMessage:
+		return c.handleUploadChunkRequest(p)
+	case protocol.UploadCommitRequestMessage:
+		return c.handleUploadCommitRequest(p)
+	case protocol.DownloadRequestMessage:
+		return c.handleDownloadRequest(p)
+	case protocol.SetPresenceRequestMessage:
+		return c.handleSetPresenceRequest(p)
+	case protocol.GetPresenceRequestMessage:
+		return c.handleGetPresenceRequest(p)
+	case protocol.SubscribePresenceRequestMessage,
+		protocol.UnsubscribePresenceRequestMessage:
+		return c.handleSubscribePresenceRequest(p)
 	}
 	return nil
 }
-
-func (c *Client) handleRecvMessage(message []byte) {
-	p, err := parseMessage(message)
-	if err != nil {
-		log.Error(err)
-		return
-	}
-	if err := c.dispatchPacket(p); err != nil {
-		log.Error(err)
-		return
-	}
-}
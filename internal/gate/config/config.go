@@ -3,32 +3,118 @@ package config
 import (
 	"flag"
 	"github.com/BurntSushi/toml"
+	"github.com/RainJoe/mim/internal/gate/blob"
+	"github.com/RainJoe/mim/internal/gate/lb"
+	"github.com/RainJoe/mim/pkg/redis"
+	"net"
+	"os"
 )
 
 var (
-	Conf *Config
+	Conf    *Config
 	cfgPath string
 )
 
 type Config struct {
 	WebSocketGate WebSocketGateConfig `toml:"websocket"`
+	TCPGate       TCPGateConfig       `toml:"tcp"`
+	HTTPGate      HTTPGateConfig      `toml:"http"`
+	Blob          blob.Config         `toml:"blob"`
+	Redis         redis.Config        `toml:"redis"`
+	Logic         lb.Config           `toml:"logic"`
 }
 
 type WebSocketGateConfig struct {
 	Addr            string
 	ReadBufferSize  int
 	WriteBufferSize int
-	LogicServerAddr string
 	PushServerAddr  string
+	// ID of the gate in the gate registry, PushAdvertiseAddr by default
+	ID string
+	// PushAdvertiseAddr is the push address logic dials, the host name with
+	// the port of PushServerAddr by default
+	PushAdvertiseAddr string
+	// RegistryTTL in seconds the registration of the gate lives without a
+	// refresh, the gate refreshes it every third of the TTL
+	RegistryTTL int
+	// AuthTimeout in seconds, connections not authenticated in time are closed
+	AuthTimeout int
+	// SendQueue is the number of packets queued for each connection
+	SendQueue int
+	// SlowConsumer is the policy for pushes to connections whose send queue
+	// is full, one of the SlowConsumer constants
+	SlowConsumer string
+	// HubShards is the number of goroutines the connected clients are
+	// partitioned over by uid, GOMAXPROCS by default
+	HubShards int
+	// Workers is the number of goroutines processing the requests of the
+	// websocket and tcp connections
+	Workers int
+	// RequestQueue is the number of requests queued per connection, reading
+	// from a connection pauses while its queue is full
+	RequestQueue int
+	// PresenceInterval in seconds the connected devices are reported to
+	// logic, 0 disables the presence heartbeats
+	PresenceInterval int
+}
+
+// TCPGateConfig the tcp gate is disabled when Addr is empty
+type TCPGateConfig struct {
+	Addr            string
+	ReadBufferSize  int
+	WriteBufferSize int
+	// AuthTimeout in seconds, connections not authenticated in time are closed
+	AuthTimeout int
+	// SendQueue is the number of packets queued for each connection
+	SendQueue int
+	// SlowConsumer is the policy for pushes to connections whose send queue
+	// is full, one of the SlowConsumer constants
+	SlowConsumer string
+}
+
+// HTTPGateConfig the http gate is disabled when Addr is empty,
+// PollTimeout and SessionTimeout are in seconds
+type HTTPGateConfig struct {
+	Addr           string
+	PollTimeout    int
+	SessionTimeout int
+}
+
+const (
+	// SlowConsumerDrop drops the push, messages are pushed again when they
+	// are not acked in time
+	SlowConsumerDrop = "drop"
+	// SlowConsumerDisconnect closes the connection
+	SlowConsumerDisconnect = "disconnect"
+	// SlowConsumerOffline skips pushes until the client caught up and then
+	// tells it to pull the messages it missed from the offline storage
+	SlowConsumerOffline = "offline"
+)
+
+// GateID returns the id the gate registers under
+func (c *WebSocketGateConfig) GateID() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	return c.PushAdvertise()
+}
+
+// PushAdvertise returns the push address the gate registers
+func (c *WebSocketGateConfig) PushAdvertise() string {
+	if c.PushAdvertiseAddr != "" {
+		return c.PushAdvertiseAddr
+	}
+	host, _ := os.Hostname()
+	_, port, _ := net.SplitHostPort(c.PushServerAddr)
+	return net.JoinHostPort(host, port)
 }
 
 func init() {
 	flag.StringVar(&cfgPath, "cfg", "", "default config path")
 }
 
-
-//Init config
+// Init config
 func Init() (err error) {
 	_, err = toml.DecodeFile(cfgPath, &Conf)
 	return
-}
\ No newline at end of file
+}
@@ -0,0 +1,146 @@
+package gate
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// reply sends the response of a request back to the client
+func (c *Client) reply(cmd uint32, rsp proto.Message) error {
+	sendPacket, err := protocol.NewPacket(protocol.V1, cmd, rsp)
+	if err != nil {
+		return err
+	}
+	return c.sendPacket(sendPacket)
+}
+
+func (c *Client) handleCreateGroupRequest(p *protocol.Packet) error {
+	req := pb.CreateGroupRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.CreateGroup(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.CreateGroupResponseMessage, rsp)
+}
+
+func (c *Client) handleRenameGroupRequest(p *protocol.Packet) error {
+	req := pb.RenameGroupRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.RenameGroup(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.RenameGroupResponseMessage, rsp)
+}
+
+// handleGroupRequest handles the commands that only carry the group
+func (c *Client) handleGroupRequest(p *protocol.Packet) error {
+	req := pb.GroupRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	var (
+		rsp proto.Message
+		err error
+	)
+	switch p.Header.Cmd {
+	case protocol.DisbandGroupRequestMessage:
+		rsp, err = c.logicService.DisbandGroup(context.TODO(), &req)
+	case protocol.JoinGroupRequestMessage:
+		rsp, err = c.logicService.JoinGroup(context.TODO(), &req)
+	case protocol.LeaveGroupRequestMessage:
+		rsp, err = c.logicService.LeaveGroup(context.TODO(), &req)
+	case protocol.ListGroupMembersRequestMessage:
+		rsp, err = c.logicService.ListGroupMembers(context.TODO(), &req)
+	}
+	if err != nil {
+		return err
+	}
+	// every response command directly follows its request command
+	return c.reply(p.Header.Cmd+1, rsp)
+}
+
+func (c *Client) handleGroupMembersRequest(p *protocol.Packet) error {
+	req := pb.GroupMembersRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	var (
+		rsp *pb.GroupResponse
+		err error
+	)
+	if p.Header.Cmd == protocol.InviteGroupMembersRequestMessage {
+		rsp, err = c.logicService.InviteGroupMembers(context.TODO(), &req)
+	} else {
+		rsp, err = c.logicService.RemoveGroupMembers(context.TODO(), &req)
+	}
+	if err != nil {
+		return err
+	}
+	return c.reply(p.Header.Cmd+1, rsp)
+}
+
+func (c *Client) handleListUserGroupsRequest(p *protocol.Packet) error {
+	req := pb.ListUserGroupsRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.ListUserGroups(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.ListUserGroupsResponseMessage, rsp)
+}
+
+func (c *Client) handleMuteGroupRequest(p *protocol.Packet) error {
+	req := pb.MuteGroupRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.MuteGroup(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.MuteGroupResponseMessage, rsp)
+}
+
+func (c *Client) handleSetGroupAdminRequest(p *protocol.Packet) error {
+	req := pb.SetGroupAdminRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.SetGroupAdmin(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.SetGroupAdminResponseMessage, rsp)
+}
+
+func (c *Client) handleTransferGroupOwnerRequest(p *protocol.Packet) error {
+	req := pb.TransferGroupOwnerRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.TransferGroupOwner(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.TransferGroupOwnerResponseMessage, rsp)
+}
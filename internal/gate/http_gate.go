@@ -0,0 +1,635 @@
+package gate
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/RainJoe/mim/internal/gate/blob"
+	"github.com/RainJoe/mim/internal/gate/config"
+	pbblob "github.com/RainJoe/mim/pb/blob"
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"github.com/RainJoe/mim/protocol"
+)
+
+const (
+	// Header carrying the session id returned by /auth, the "sid" query
+	// parameter may be used instead.
+	sessionHeader = "X-Session-Id"
+
+	// Maximum number of pushes buffered for a session between two polls.
+	maxPollQueue = 256
+)
+
+var (
+	errSessionExpired = errors.New("http session expired")
+
+	marshaler   = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	unmarshaler = jsonpb.Unmarshaler{AllowUnknownFields: true}
+)
+
+// HTTPGate serves clients that can not hold a long lived connection. Requests
+// are plain JSON encoded protobuf messages, pushes are delivered by long
+// polling /poll with the session id returned from /auth.
+type HTTPGate struct {
+	conf         *config.HTTPGateConfig
+	logicService pb.LogicServiceClient
+	hub          *Hub
+	blobs        *BlobService
+
+	mu       sync.Mutex
+	sessions map[string]*httpSession
+}
+
+func NewHTTPGate(conf *config.HTTPGateConfig, hub *Hub, logicService pb.LogicServiceClient, blobs *BlobService) *HTTPGate {
+	return &HTTPGate{
+		conf:         conf,
+		logicService: logicService,
+		hub:          hub,
+		blobs:        blobs,
+		sessions:     make(map[string]*httpSession),
+	}
+}
+
+// Handler returns the http handler of the gate.
+func (g *HTTPGate) Handler() http.Handler {
+	router := http.NewServeMux()
+	router.HandleFunc("/auth", post(g.serveAuth))
+	router.HandleFunc("/logout", g.handle(&pb.LogoutRequest{}, g.logout))
+	router.HandleFunc("/c2c/send", g.handle(&pb.C2CSendRequest{}, g.c2cSend))
+	router.HandleFunc("/c2g/send", g.handle(&pb.C2GSendRequest{}, g.c2gSend))
+	router.HandleFunc("/pull", g.handle(&pb.C2SPullMessageRequest{}, g.pull))
+	router.HandleFunc("/pull/seq", g.handle(&pb.PullBySeqRequest{}, g.pullBySeq))
+	router.HandleFunc("/recall", g.handle(&pb.RecallRequest{}, g.recall))
+	router.HandleFunc("/read", g.handle(&pb.MarkReadRequest{}, g.markRead))
+	router.HandleFunc("/read/counts", g.handle(&pb.ReadCountsRequest{}, g.readCounts))
+	router.HandleFunc("/conversations", g.handle(&pb.ListConversationsRequest{}, g.listConversations))
+	router.HandleFunc("/conversations/clear", g.handle(&pb.ClearUnreadRequest{}, g.clearUnread))
+	router.HandleFunc("/history", g.handle(&pb.HistoryRequest{}, g.history))
+	router.HandleFunc("/signal", g.handle(&pb.SignalRequest{}, g.signal))
+	router.HandleFunc("/presence", g.handle(&pb.GetPresenceRequest{}, g.getPresence))
+	router.HandleFunc("/presence/set", g.handle(&pb.SetPresenceRequest{}, g.setPresence))
+	router.HandleFunc("/presence/subscribe", g.handle(&pb.SubscribePresenceRequest{}, g.subscribePresence))
+	router.HandleFunc("/presence/unsubscribe", g.handle(&pb.SubscribePresenceRequest{}, g.unsubscribePresence))
+	router.HandleFunc("/upload/begin", g.handleBlob(&pbblob.UploadBeginRequest{}, g.uploadBegin))
+	router.HandleFunc("/upload/chunk", post(g.serveUploadChunk))
+	router.HandleFunc("/upload/commit", g.handleBlob(&pbblob.UploadCommitRequest{}, g.uploadCommit))
+	router.HandleFunc("/blob/", g.serveBlob)
+	router.HandleFunc("/poll", g.servePoll)
+	router.HandleFunc("/push/ack", post(g.servePushAck))
+	return router
+}
+
+func post(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		h(w, r)
+	}
+}
+
+func readProto(r *http.Request, pb proto.Message) error {
+	defer r.Body.Close()
+	return unmarshaler.Unmarshal(io.LimitReader(r.Body, maxMessageSize), pb)
+}
+
+func writeProto(w http.ResponseWriter, pb proto.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := marshaler.Marshal(w, pb); err != nil {
+		log.Error(err)
+	}
+}
+
+// newSession creates the session of the device, it is looked up by its id
+// from then on.
+func (g *HTTPGate) newSession(uid string, deviceID string) (*httpSession, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	s := &httpSession{
+		id:       hex.EncodeToString(b),
+		uid:      uid,
+		deviceID: deviceID,
+		timeout:  time.Duration(g.conf.SessionTimeout) * time.Second,
+		lastPoll: time.Now(),
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	s.onClose = func() {
+		g.mu.Lock()
+		delete(g.sessions, s.id)
+		g.mu.Unlock()
+	}
+	g.mu.Lock()
+	g.sessions[s.id] = s
+	g.mu.Unlock()
+	return s, nil
+}
+
+func (g *HTTPGate) session(r *http.Request) *httpSession {
+	sid := r.Header.Get(sessionHeader)
+	if sid == "" {
+		sid = r.URL.Query().Get("sid")
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sessions[sid]
+}
+
+func (g *HTTPGate) serveAuth(w http.ResponseWriter, r *http.Request) {
+	req := pb.AuthRequest{}
+	if err := readProto(r, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.GateId = g.hub.id
+	rsp, err := g.logicService.Auth(r.Context(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if rsp.Status == 0 {
+		s, err := g.newSession(req.Uid, req.DeviceId)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// http requests are answered by the handlers, the session never
+		// reads packets and needs no workers
+		c := newClient(s, g.hub, g.logicService, g.blobs, nil, sendOptions{})
+		c.authenticate(req.Uid, req.DeviceId, req.Platform)
+		g.hub.register(c)
+		c.serve(0)
+		w.Header().Set(sessionHeader, s.id)
+	}
+	writeProto(w, rsp)
+}
+
+// sessionCall forwards the decoded request of a session and returns the
+// response. req is a new message of the type the call was registered with.
+type sessionCall func(ctx context.Context, s *httpSession, req proto.Message) (proto.Message, error)
+
+// handle serves the POST requests of sessions forwarded to logic: the JSON
+// body is decoded into a new message of the type of req, call binds it to the
+// session and forwards it, and the response is written back.
+func (g *HTTPGate) handle(req proto.Message, call sessionCall) http.HandlerFunc {
+	return g.serveCall(req, call, http.StatusBadGateway)
+}
+
+// handleBlob is handle for the calls served by the blob store of the gate.
+func (g *HTTPGate) handleBlob(req proto.Message, call sessionCall) http.HandlerFunc {
+	return g.serveCall(req, call, http.StatusInternalServerError)
+}
+
+// serveCall answers requests without a session with 401, bodies that do not
+// decode with 400 and errors of the call with failure.
+func (g *HTTPGate) serveCall(req proto.Message, call sessionCall, failure int) http.HandlerFunc {
+	return post(func(w http.ResponseWriter, r *http.Request) {
+		s := g.session(r)
+		if s == nil {
+			http.Error(w, errSessionExpired.Error(), http.StatusUnauthorized)
+			return
+		}
+		// a fresh message for every request, req only carries the type
+		in := proto.Clone(req)
+		in.Reset()
+		if err := readProto(r, in); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rsp, err := call(r.Context(), s, in)
+		if err != nil {
+			http.Error(w, err.Error(), failure)
+			return
+		}
+		writeProto(w, rsp)
+	})
+}
+
+func (g *HTTPGate) logout(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.LogoutRequest)
+	req.Uid = s.uid
+	rsp, err := g.logicService.Logout(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.Close()
+	return rsp, nil
+}
+
+func (g *HTTPGate) c2cSend(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.C2CSendRequest)
+	req.From = s.uid
+	return g.logicService.C2CSend(ctx, req)
+}
+
+func (g *HTTPGate) c2gSend(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.C2GSendRequest)
+	req.From = s.uid
+	return g.logicService.C2GSend(ctx, req)
+}
+
+func (g *HTTPGate) pull(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.C2SPullMessageRequest)
+	req.Uid = s.uid
+	return g.logicService.C2SPull(ctx, req)
+}
+
+func (g *HTTPGate) pullBySeq(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.PullBySeqRequest)
+	req.Uid = s.uid
+	return g.logicService.PullBySeq(ctx, req)
+}
+
+func (g *HTTPGate) recall(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.RecallRequest)
+	req.Uid = s.uid
+	return g.logicService.Recall(ctx, req)
+}
+
+func (g *HTTPGate) markRead(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.MarkReadRequest)
+	req.Uid = s.uid
+	return g.logicService.MarkRead(ctx, req)
+}
+
+func (g *HTTPGate) readCounts(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.ReadCountsRequest)
+	req.Uid = s.uid
+	return g.logicService.GetReadCounts(ctx, req)
+}
+
+func (g *HTTPGate) listConversations(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.ListConversationsRequest)
+	req.Uid = s.uid
+	return g.logicService.ListConversations(ctx, req)
+}
+
+func (g *HTTPGate) clearUnread(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.ClearUnreadRequest)
+	req.Uid = s.uid
+	return g.logicService.ClearUnread(ctx, req)
+}
+
+func (g *HTTPGate) history(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.HistoryRequest)
+	req.Uid = s.uid
+	return g.logicService.History(ctx, req)
+}
+
+func (g *HTTPGate) signal(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.SignalRequest)
+	req.From = s.uid
+	return g.logicService.Signal(ctx, req)
+}
+
+func (g *HTTPGate) setPresence(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.SetPresenceRequest)
+	req.Uid = s.uid
+	req.DeviceId = s.deviceID
+	req.GateId = g.hub.id
+	return g.logicService.SetPresence(ctx, req)
+}
+
+func (g *HTTPGate) getPresence(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.GetPresenceRequest)
+	req.Uid = s.uid
+	return g.logicService.GetPresence(ctx, req)
+}
+
+func (g *HTTPGate) subscribePresence(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.SubscribePresenceRequest)
+	req.Uid = s.uid
+	return g.logicService.SubscribePresence(ctx, req)
+}
+
+func (g *HTTPGate) unsubscribePresence(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	req := m.(*pb.SubscribePresenceRequest)
+	req.Uid = s.uid
+	return g.logicService.UnsubscribePresence(ctx, req)
+}
+
+func (g *HTTPGate) uploadBegin(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	return g.blobs.Begin(s.uid, m.(*pbblob.UploadBeginRequest))
+}
+
+// serveUploadChunk takes the raw bytes of the chunk as body, the upload id
+// and offset are passed as query parameters
+func (g *HTTPGate) serveUploadChunk(w http.ResponseWriter, r *http.Request) {
+	s := g.session(r)
+	if s == nil {
+		http.Error(w, errSessionExpired.Error(), http.StatusUnauthorized)
+		return
+	}
+	offset, err := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+	if err != nil {
+		http.Error(w, "bad offset", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+	// read past the chunk size so Chunk can reject oversized chunks
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxMessageSize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := pbblob.UploadChunkRequest{
+		UploadId: r.URL.Query().Get("upload_id"),
+		Offset:   offset,
+		Data:     data,
+	}
+	rsp, err := g.blobs.Chunk(s.uid, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeProto(w, rsp)
+}
+
+func (g *HTTPGate) uploadCommit(ctx context.Context, s *httpSession, m proto.Message) (proto.Message, error) {
+	return g.blobs.Commit(s.uid, m.(*pbblob.UploadCommitRequest))
+}
+
+// serveBlob serves GET /blob/{id}, range requests are supported
+// inlineTypes are the mime types of blobs a browser may show in the page,
+// their content can not run scripts
+var inlineTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// setBlobType sets the headers of a blob download. The mime type is given by
+// the uploader, blobs of other types are downloaded as opaque attachments so
+// an uploaded page never runs in the origin of the gate.
+func setBlobType(h http.Header, mimeType string) {
+	h.Set("X-Content-Type-Options", "nosniff")
+	if inlineTypes[mimeType] {
+		h.Set("Content-Type", mimeType)
+		h.Set("Content-Disposition", "inline")
+		return
+	}
+	h.Set("Content-Type", "application/octet-stream")
+	h.Set("Content-Disposition", "attachment")
+}
+
+func (g *HTTPGate) serveBlob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if g.session(r) == nil {
+		http.Error(w, errSessionExpired.Error(), http.StatusUnauthorized)
+		return
+	}
+	if g.blobs.disabled() {
+		http.NotFound(w, r)
+		return
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/blob/")
+	b, info, err := g.blobs.store.Open(id)
+	if err == blob.ErrNotFound {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer b.Close()
+	setBlobType(w.Header(), info.MimeType)
+	// the content of a blob never changes
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	w.Header().Set("ETag", `"`+info.ID+`"`)
+	http.ServeContent(w, r, "", time.Time{}, b)
+}
+
+// pollMessage is a push delivered by /poll.
+type pollMessage struct {
+	Cmd  uint32          `json:"cmd"`
+	Body json.RawMessage `json:"body"`
+}
+
+// servePoll waits until there are pushes for the session or the poll
+// timeout expires. The timeout may be shortened by the "timeout" query
+// parameter in seconds.
+func (g *HTTPGate) servePoll(w http.ResponseWriter, r *http.Request) {
+	s := g.session(r)
+	if s == nil {
+		http.Error(w, errSessionExpired.Error(), http.StatusUnauthorized)
+		return
+	}
+	wait := time.Duration(g.conf.PollTimeout) * time.Second
+	if t, err := strconv.Atoi(r.URL.Query().Get("timeout")); err == nil && t >= 0 && time.Duration(t)*time.Second < wait {
+		wait = time.Duration(t) * time.Second
+	}
+	pms := make([]*pollMessage, 0)
+	for _, message := range s.poll(r.Context(), wait) {
+		pm, err := decodePush(message)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		pms = append(pms, pm)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(pms); err != nil {
+		log.Error(err)
+	}
+}
+
+// servePushAck acks a message push received by /poll. The request has the
+// form of a poll message, cmd is the cmd of the push and body the
+// C2CPushResponse or C2GPushResponse to it.
+func (g *HTTPGate) servePushAck(w http.ResponseWriter, r *http.Request) {
+	s := g.session(r)
+	if s == nil {
+		http.Error(w, errSessionExpired.Error(), http.StatusUnauthorized)
+		return
+	}
+	pm := pollMessage{}
+	defer r.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxMessageSize)).Decode(&pm); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var (
+		rsp *pb.Response
+		err error
+	)
+	switch pm.Cmd {
+	case protocol.C2CPushRequestMessage:
+		req := pb.C2CPushResponse{}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(pm.Body), &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Uid = s.uid
+		rsp, err = g.logicService.C2CPushAck(r.Context(), &req)
+	case protocol.C2GPushRequestMessage:
+		req := pb.C2GPushResponse{}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(pm.Body), &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Uid = s.uid
+		rsp, err = g.logicService.C2GPushAck(r.Context(), &req)
+	default:
+		http.Error(w, "push cmd without ack", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	writeProto(w, rsp)
+}
+
+func decodePush(message []byte) (*pollMessage, error) {
+	p, err := parseMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	pm := &pollMessage{Cmd: p.Header.Cmd, Body: json.RawMessage("null")}
+	var body proto.Message
+	switch p.Header.Cmd {
+	case protocol.LogoutRequestMessage:
+		body = &pbpush.KickOutRequest{}
+	case protocol.C2CPushRequestMessage:
+		body = &pbpush.C2CPushRequest{}
+	case protocol.C2GPushRequestMessage:
+		body = &pbpush.C2GPushRequest{}
+	case protocol.RecallPushRequestMessage:
+		body = &pbpush.RecallNotice{}
+	case protocol.ReadReceiptPushMessage:
+		body = &pbpush.ReadReceipt{}
+	case protocol.PresencePushMessage:
+		body = &pbpush.PresenceNotice{}
+	case protocol.SignalPushMessage:
+		body = &pbpush.SignalNotice{}
+	default:
+		return pm, nil
+	}
+	if err := proto.Unmarshal(p.Body(), body); err != nil {
+		return nil, err
+	}
+	buf := bytes.Buffer{}
+	if err := marshaler.Marshal(&buf, body); err != nil {
+		return nil, err
+	}
+	pm.Body = buf.Bytes()
+	return pm, nil
+}
+
+// httpSession is the Conn of a http client. Pushes written by the hub are
+// queued until the next poll, the session expires when it is not polled
+// within the session timeout.
+type httpSession struct {
+	id       string
+	uid      string
+	deviceID string
+	timeout  time.Duration
+
+	mu       sync.Mutex
+	queue    [][]byte
+	lastPoll time.Time
+
+	notify    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	onClose   func()
+}
+
+func (s *httpSession) touch() {
+	s.mu.Lock()
+	s.lastPoll = time.Now()
+	s.mu.Unlock()
+}
+
+func (s *httpSession) take() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	messages := s.queue
+	s.queue = nil
+	return messages
+}
+
+func (s *httpSession) poll(ctx context.Context, wait time.Duration) [][]byte {
+	s.touch()
+	defer s.touch()
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		if messages := s.take(); len(messages) > 0 {
+			return messages
+		}
+		select {
+		case <-s.notify:
+		case <-s.done:
+			return s.take()
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// ReadMessage blocks until the session is closed, requests of http clients
+// don't go through the read pump.
+func (s *httpSession) ReadMessage() ([]byte, error) {
+	<-s.done
+	return nil, io.EOF
+}
+
+func (s *httpSession) WriteMessage(data []byte) error {
+	s.mu.Lock()
+	if len(s.queue) >= maxPollQueue {
+		log.Errorf("session %s poll queue full, dropping oldest push", s.id)
+		s.queue = s.queue[1:]
+	}
+	s.queue = append(s.queue, data)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Ping expires the session when the client stopped polling.
+func (s *httpSession) Ping() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastPoll) > s.timeout {
+		return errSessionExpired
+	}
+	return nil
+}
+
+func (s *httpSession) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.onClose()
+	})
+	return nil
+}
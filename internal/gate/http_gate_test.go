@@ -0,0 +1,83 @@
+package gate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/RainJoe/mim/internal/gate/config"
+	pb "github.com/RainJoe/mim/pb/logic"
+)
+
+// echoLogic answers sends with the sender logic received
+type echoLogic struct {
+	pb.LogicServiceClient
+}
+
+func (echoLogic) C2CSend(ctx context.Context, in *pb.C2CSendRequest, opts ...grpc.CallOption) (*pb.C2CSendResponse, error) {
+	return &pb.C2CSendResponse{Seq: in.Seq, Msg: in.From}, nil
+}
+
+func TestHTTPGateHandle(t *testing.T) {
+	g := NewHTTPGate(&config.HTTPGateConfig{SessionTimeout: 60}, NewHub("test", 1), echoLogic{}, nil)
+	s, err := g.newSession("alice", "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := g.Handler()
+	tests := []struct {
+		name   string
+		method string
+		sid    string
+		body   string
+		code   int
+		rsp    string
+	}{
+		{"bound to the session", http.MethodPost, s.id, `{"from":"mallory","to":"bob","seq":7}`, http.StatusOK, `"msg":"alice"`},
+		{"new message per request", http.MethodPost, s.id, `{"to":"bob"}`, http.StatusOK, `"seq":"0"`},
+		{"no session", http.MethodPost, "", `{"to":"bob"}`, http.StatusUnauthorized, ""},
+		{"bad body", http.MethodPost, s.id, `{"seq":`, http.StatusBadRequest, ""},
+		{"not post", http.MethodGet, s.id, "", http.StatusMethodNotAllowed, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/c2c/send", strings.NewReader(tt.body))
+			r.Header.Set(sessionHeader, tt.sid)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.code {
+				t.Fatalf("status %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+			if !strings.Contains(w.Body.String(), tt.rsp) {
+				t.Errorf("response %s, want %s", w.Body, tt.rsp)
+			}
+		})
+	}
+}
+
+func TestSetBlobType(t *testing.T) {
+	tests := []struct {
+		mimeType    string
+		contentType string
+		disposition string
+	}{
+		{"image/png", "image/png", "inline"},
+		{"image/svg+xml", "application/octet-stream", "attachment"},
+		{"text/html", "application/octet-stream", "attachment"},
+		{"", "application/octet-stream", "attachment"},
+	}
+	for _, tt := range tests {
+		h := make(http.Header)
+		setBlobType(h, tt.mimeType)
+		if h.Get("Content-Type") != tt.contentType || h.Get("Content-Disposition") != tt.disposition {
+			t.Errorf("%q served as %s, %s", tt.mimeType, h.Get("Content-Type"), h.Get("Content-Disposition"))
+		}
+		if h.Get("X-Content-Type-Options") != "nosniff" {
+			t.Errorf("%q served without nosniff", tt.mimeType)
+		}
+	}
+}
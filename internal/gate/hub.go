@@ -1,14 +1,38 @@
 package gate
 
 import (
+	"hash/crc32"
+	"runtime"
+	"sync"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	"github.com/RainJoe/mim/protocol"
 	"github.com/golang/protobuf/proto"
 )
 
+// shardBacklog is the number of requests queued for each hub shard.
+const shardBacklog = 1024
+
 // Hub maintains the set of active clients and broadcasts messages to the
-// clients.
+// clients. The clients are partitioned by uid over shards, each running on
+// its own goroutine, so all connections of a user are handled by one shard.
 type Hub struct {
+	// Id the gate registered under, sent to logic with the requests that
+	// bind a device to the gate.
+	id string
+
+	shards []*hubShard
+
+	// Devices whose last connection closed, reported by ReportPresence.
+	offline chan *pb.PresenceDevice
+}
+
+// hubShard owns the clients of the users hashed to it.
+type hubShard struct {
+	hub *Hub
+
 	// Registered clients.
 	clients map[*Client]bool
 
@@ -21,51 +45,165 @@ type Hub struct {
 	// Unregister requests from clients.
 	unregister chan *Client
 
-	users map[string]*Client
+	// Registered clients of a user keyed by device id.
+	users map[string]map[string]*Client
+
+	// Snapshot requests of the registered devices.
+	devices chan chan []*pb.PresenceDevice
 }
 
 type PushMessage struct {
-	uid string
-	mType uint32
+	uid     string
+	mType   uint32
 	message proto.Message
 }
 
-func newHub() *Hub {
-	return &Hub{
-		push:  make(chan *PushMessage),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		clients:    make(map[*Client]bool),
-		users: make(map[string]*Client),
+// NewHub returns a hub with the given number of shards, GOMAXPROCS when
+// shards is not positive.
+func NewHub(id string, shards int) *Hub {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	h := &Hub{
+		id:      id,
+		shards:  make([]*hubShard, shards),
+		offline: make(chan *pb.PresenceDevice, offlineBacklog),
+	}
+	for i := range h.shards {
+		h.shards[i] = &hubShard{
+			hub:        h,
+			push:       make(chan *PushMessage, shardBacklog),
+			register:   make(chan *Client, shardBacklog),
+			unregister: make(chan *Client, shardBacklog),
+			clients:    make(map[*Client]bool),
+			users:      make(map[string]map[string]*Client),
+			devices:    make(chan chan []*pb.PresenceDevice),
+		}
+	}
+	return h
+}
+
+// Run runs the shards of the hub, it returns when all of them returned.
+func (h *Hub) Run() {
+	var wg sync.WaitGroup
+	for _, s := range h.shards {
+		wg.Add(1)
+		go func(s *hubShard) {
+			defer wg.Done()
+			s.run()
+		}(s)
 	}
+	wg.Wait()
+}
+
+func (h *Hub) shard(uid string) *hubShard {
+	return h.shards[crc32.ChecksumIEEE([]byte(uid))%uint32(len(h.shards))]
+}
+
+// register adds the authenticated client, replacing an older connection of
+// its device.
+func (h *Hub) register(client *Client) {
+	h.shard(client.userID()).register <- client
 }
 
-func (h *Hub) run() {
+// unregister removes the client when its connection closed.
+func (h *Hub) unregister(client *Client) {
+	h.shard(client.userID()).unregister <- client
+}
+
+// push delivers the message to the connected clients of its user.
+func (h *Hub) push(pm *PushMessage) {
+	h.shard(pm.uid).push <- pm
+}
+
+// run handles the register, unregister and push requests of the shard.
+func (s *hubShard) run() {
 	for {
 		select {
-		case client := <-h.register:
-			h.clients[client] = true
-			h.users[client.uid] = client
-		case client := <-h.unregister:
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				delete(h.users, client.uid)
-				close(client.send)
+		case client := <-s.register:
+			if client.isClosed() {
+				// torn down while authenticating, its unregister
+				// found nothing to remove
+				continue
+			}
+			uid, deviceID := client.identity()
+			devices, ok := s.users[uid]
+			if !ok {
+				devices = make(map[string]*Client)
+				s.users[uid] = devices
 			}
-		case pm := <-h.push:
-			if pm.mType ==  protocol.LogoutRequestMessage {
-				if client, ok := h.users[pm.uid]; ok {
-					delete(h.clients, client)
-					delete(h.users, client.uid)
-					close(client.send)
+			if old, ok := devices[deviceID]; ok && old != client {
+				// The device logged in again, drop the old connection.
+				s.kickOut(old, &pbpush.KickOutRequest{
+					Uid:      uid,
+					DeviceId: deviceID,
+					Reason:   int32(pbpush.KickOutRequest_OTHER_LOGIN),
+				})
+			}
+			s.clients[client] = true
+			devices[deviceID] = client
+		case client := <-s.unregister:
+			if s.remove(client) {
+				s.hub.reportOffline(client)
+			}
+		case reply := <-s.devices:
+			reply <- s.snapshot()
+		case pm := <-s.push:
+			if pm.mType == protocol.LogoutRequestMessage {
+				req := pm.message.(*pbpush.KickOutRequest)
+				for deviceID, client := range s.users[pm.uid] {
+					if req.DeviceId == "" || req.DeviceId == deviceID {
+						s.kickOut(client, req)
+					}
 				}
+				continue
+			}
+			clients := s.users[pm.uid]
+			if len(clients) == 0 {
+				continue
+			}
+			p, _ := protocol.NewPacket(protocol.V1, pm.mType, pm.message)
+			b, err := p.Pack()
+			if err != nil {
+				log.Error(err)
+				continue
 			}
-			if client, ok := h.users[pm.uid]; ok {
-				p, _ := protocol.NewPacket(protocol.V1, pm.mType, pm.message)
-				if err := client.sendPacket(p); err != nil {
+			for _, client := range clients {
+				if err := client.push(b); err != nil {
 					log.Error(err)
 				}
 			}
 		}
 	}
 }
+
+// kickOut tells the client why it is disconnected and removes it.
+func (s *hubShard) kickOut(client *Client, req *pbpush.KickOutRequest) {
+	p, _ := protocol.NewPacket(protocol.V1, protocol.LogoutRequestMessage, req)
+	if b, err := p.Pack(); err != nil {
+		log.Error(err)
+	} else if err := client.push(b); err != nil {
+		log.Error(err)
+	}
+	s.remove(client)
+}
+
+// remove unregisters the client, it returns true if the client was the
+// registered connection of its device.
+func (s *hubShard) remove(client *Client) bool {
+	if _, ok := s.clients[client]; !ok {
+		return false
+	}
+	delete(s.clients, client)
+	last := false
+	uid, deviceID := client.identity()
+	if devices, ok := s.users[uid]; ok && devices[deviceID] == client {
+		last = true
+		delete(devices, deviceID)
+		if len(devices) == 0 {
+			delete(s.users, uid)
+		}
+	}
+	client.close()
+	return last
+}
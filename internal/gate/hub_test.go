@@ -0,0 +1,217 @@
+package gate
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// newHubClient registers a client of the device with a running writer.
+func newHubClient(h *Hub, uid string, deviceID string) (*Client, *testConn) {
+	conn := newTestConn()
+	c := newClient(conn, h, nil, nil, nil, sendOptions{})
+	c.authenticate(uid, deviceID, "test")
+	go c.writePump()
+	h.register(c)
+	return c, conn
+}
+
+// onlineDevices returns the number of devices the hub reports online.
+func onlineDevices(h *Hub) int {
+	n := 0
+	reply := make(chan []*pb.PresenceDevice, 1)
+	for _, s := range h.shards {
+		s.devices <- reply
+		n += len(<-reply)
+	}
+	return n
+}
+
+// waitDevices waits until the hub reports n devices online, registrations
+// are handled asynchronously.
+func waitDevices(t testing.TB, h *Hub, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for onlineDevices(h) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d devices online, want %d", onlineDevices(h), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func waitWritten(t *testing.T, conn *testConn, want int64) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt64(&conn.written) < want {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d packets written, want %d", atomic.LoadInt64(&conn.written), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHubPush(t *testing.T) {
+	h := NewHub("test", 4)
+	go h.Run()
+	conns := make(map[string]*testConn)
+	for i := 0; i < 20; i++ {
+		uid := strconv.Itoa(i)
+		for _, device := range []string{"phone", "desktop"} {
+			_, conns[uid+device] = newHubClient(h, uid, device)
+		}
+	}
+	waitDevices(t, h, 40)
+	for i := 0; i < 20; i += 2 {
+		h.push(&PushMessage{strconv.Itoa(i), protocol.C2CPushRequestMessage, &pbpush.C2CPushRequest{}})
+	}
+	for i := 0; i < 20; i++ {
+		want := int64(0)
+		if i%2 == 0 {
+			want = 1
+		}
+		for _, device := range []string{"phone", "desktop"} {
+			conn := conns[strconv.Itoa(i)+device]
+			waitWritten(t, conn, want)
+			if written := atomic.LoadInt64(&conn.written); written != want {
+				t.Errorf("%d packets written to %d %s, want %d", written, i, device, want)
+			}
+		}
+	}
+}
+
+func TestHubKickOut(t *testing.T) {
+	h := NewHub("test", 4)
+	go h.Run()
+	old, oldConn := newHubClient(h, "alice", "phone")
+	newHubClient(h, "alice", "desktop")
+	// the device logging in again replaces its connection
+	newHubClient(h, "alice", "phone")
+	select {
+	case <-old.done:
+	case <-time.After(time.Second):
+		t.Fatal("replaced connection not closed")
+	}
+	waitWritten(t, oldConn, 1)
+	waitDevices(t, h, 2)
+	h.push(&PushMessage{"alice", protocol.LogoutRequestMessage, &pbpush.KickOutRequest{Uid: "alice"}})
+	waitDevices(t, h, 0)
+}
+
+func TestHubRegisterAfterClose(t *testing.T) {
+	h := NewHub("test", 4)
+	go h.Run()
+	c := newClient(newTestConn(), h, nil, nil, nil, sendOptions{})
+	c.authenticate("alice", "phone", "test")
+	// the connection is torn down before the registration is handled
+	c.markClosed()
+	h.unregister(c)
+	h.register(c)
+	// registrations of a user are handled in order, the one of the closed
+	// client is handled before the later one
+	newHubClient(h, "alice", "desktop")
+	deadline := time.Now().Add(time.Second)
+	for {
+		reply := make(chan []*pb.PresenceDevice, 1)
+		h.shard("alice").devices <- reply
+		online := <-reply
+		if len(online) > 0 {
+			if len(online) != 1 || online[0].DeviceId != "desktop" {
+				t.Fatalf("closed client registered, online %v", online)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("registration not handled")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if c.authenticate("bob", "phone", "test") {
+		t.Error("torn down connection authenticated")
+	}
+}
+
+func TestHubUnregister(t *testing.T) {
+	h := NewHub("test", 4)
+	go h.Run()
+	c, _ := newHubClient(h, "alice", "phone")
+	waitDevices(t, h, 1)
+	c.markClosed()
+	// torn down connections are not reported online while they wait to be
+	// unregistered
+	if n := onlineDevices(h); n != 0 {
+		t.Errorf("%d devices reported online, want 0", n)
+	}
+	h.unregister(c)
+	select {
+	case dev := <-h.offline:
+		if dev.Uid != "alice" || dev.DeviceId != "phone" {
+			t.Errorf("reported %s %s offline", dev.Uid, dev.DeviceId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("device not reported offline")
+	}
+}
+
+const benchClients = 100000
+
+// benchmarkHubPush pushes to benchClients clients connected to a hub with the
+// given number of shards from parallel goroutines, like the push service does
+// for concurrent rpc calls, and waits until all pushes are written.
+func benchmarkHubPush(b *testing.B, shards int) {
+	h := NewHub("bench", shards)
+	go h.Run()
+	conns := make([]*testConn, benchClients)
+	clients := make([]*Client, benchClients)
+	for i := range clients {
+		clients[i], conns[i] = newHubClient(h, strconv.Itoa(i), "device")
+	}
+	defer func() {
+		for _, c := range clients {
+			c.close()
+		}
+	}()
+	waitDevices(b, h, benchClients)
+	msg := &pbpush.C2CPushRequest{From: "bench", Content: "hello"}
+	var next int64
+	b.ReportAllocs()
+	b.ResetTimer()
+	start := time.Now()
+	b.RunParallel(func(p *testing.PB) {
+		for p.Next() {
+			uid := strconv.Itoa(int(atomic.AddInt64(&next, 1) % benchClients))
+			h.push(&PushMessage{uid, protocol.C2CPushRequestMessage, msg})
+		}
+	})
+	for {
+		var written int64
+		for _, conn := range conns {
+			written += atomic.LoadInt64(&conn.written)
+		}
+		if written >= int64(b.N) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "pushes/s")
+}
+
+func BenchmarkHubPush(b *testing.B) {
+	counts := []int{1, 4, 16}
+	if n := runtime.GOMAXPROCS(0); n != 1 && n != 4 && n != 16 {
+		counts = append(counts, n)
+	}
+	for _, shards := range counts {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			benchmarkHubPush(b, shards)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package lb
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retryWait is the wait before the first retry, it grows with every retry
+// to give the balancer time to take the failed server out
+const retryWait = 100 * time.Millisecond
+
+// idempotent are the logic methods that are safe to call again, a server
+// may have applied a request before it became unavailable.
+var idempotent = map[string]bool{
+	"/protocol.LogicService/Logout":              true,
+	"/protocol.LogicService/C2SPull":             true,
+	"/protocol.LogicService/C2CPushAck":          true,
+	"/protocol.LogicService/C2GPushAck":          true,
+	"/protocol.LogicService/PullBySeq":           true,
+	"/protocol.LogicService/ListGroupMembers":    true,
+	"/protocol.LogicService/ListUserGroups":      true,
+	"/protocol.LogicService/GetReadCounts":       true,
+	"/protocol.LogicService/ListConversations":   true,
+	"/protocol.LogicService/History":             true,
+	"/protocol.LogicService/Heartbeat":           true,
+	"/protocol.LogicService/SetPresence":         true,
+	"/protocol.LogicService/GetPresence":         true,
+	"/protocol.LogicService/SubscribePresence":   true,
+	"/protocol.LogicService/UnsubscribePresence": true,
+}
+
+// retryable reports whether the request may be sent again. Requests sending
+// messages are retried when they carry a client message id, logic answers a
+// retried one with the message it already saved.
+func retryable(method string, req interface{}) bool {
+	if r, ok := req.(interface{ GetClientMsgId() string }); ok {
+		return r.GetClientMsgId() != ""
+	}
+	return idempotent[method]
+}
+
+// failover balances the request by the user it is sent for and retries it
+// at most maxRetries times when its server is unavailable and the method is
+// retryable.
+func failover(maxRetries int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Value(hashKey{}).(string); !ok {
+			ctx = WithHashKey(ctx, userOf(req))
+		}
+		if !retryable(method, req) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		for i := 0; ; i++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if status.Code(err) != codes.Unavailable || i >= maxRetries {
+				return err
+			}
+			select {
+			case <-time.After(time.Duration(i+1) * retryWait):
+			case <-ctx.Done():
+				return err
+			}
+		}
+	}
+}
+
+// userOf returns the user a request is sent for, the uid or the sender
+func userOf(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetUid() string }:
+		return r.GetUid()
+	case interface{ GetFrom() string }:
+		return r.GetFrom()
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package lb
+
+import (
+	"context"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+)
+
+// replicas is the number of points of each server on the hash ring
+const replicas = 100
+
+func init() {
+	balancer.Register(base.NewBalancerBuilderV2(BalanceConsistentHash, hashPickerBuilder{}, base.Config{HealthCheck: true}))
+}
+
+type hashKey struct{}
+
+// WithHashKey returns a context whose requests are balanced by key with
+// BalanceConsistentHash
+func WithHashKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, hashKey{}, key)
+}
+
+func hashOf(s string) uint32 {
+	return crc32.ChecksumIEEE([]byte(s))
+}
+
+type point struct {
+	hash uint32
+	sc   balancer.SubConn
+}
+
+type hashPickerBuilder struct{}
+
+// Build places the ready servers on the ring by their address, every gate
+// builds the same ring for the same servers.
+func (hashPickerBuilder) Build(info base.PickerBuildInfo) balancer.V2Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPickerV2(balancer.ErrNoSubConnAvailable)
+	}
+	p := &hashPicker{
+		ring: make([]point, 0, len(info.ReadySCs)*replicas),
+		scs:  make([]balancer.SubConn, 0, len(info.ReadySCs)),
+	}
+	for sc, sci := range info.ReadySCs {
+		p.scs = append(p.scs, sc)
+		for i := 0; i < replicas; i++ {
+			p.ring = append(p.ring, point{hashOf(sci.Address.Addr + "#" + strconv.Itoa(i)), sc})
+		}
+	}
+	sort.Slice(p.ring, func(i, j int) bool {
+		return p.ring[i].hash < p.ring[j].hash
+	})
+	return p
+}
+
+// hashPicker picks the first server on the ring after the hash of the key of
+// the request, requests without a key are picked round robin.
+type hashPicker struct {
+	ring []point
+	scs  []balancer.SubConn
+	next uint32
+}
+
+func (p *hashPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	key, _ := info.Ctx.Value(hashKey{}).(string)
+	if key == "" {
+		n := atomic.AddUint32(&p.next, 1)
+		return balancer.PickResult{SubConn: p.scs[int(n)%len(p.scs)]}, nil
+	}
+	h := hashOf(key)
+	i := sort.Search(len(p.ring), func(i int) bool {
+		return p.ring[i].hash >= h
+	})
+	if i == len(p.ring) {
+		i = 0
+	}
+	return balancer.PickResult{SubConn: p.ring[i].sc}, nil
+}
@@ -0,0 +1,100 @@
+// Package lb dials the logic servers from the gate. The logic servers are
+// taken from the config or from the logic registry in redis, requests are
+// balanced over the healthy ones round robin or by consistent hashing of the
+// user id, and requests failing because their server went away are retried
+// on another one.
+package lb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	_ "google.golang.org/grpc/balancer/roundrobin"
+	_ "google.golang.org/grpc/health"
+
+	"github.com/RainJoe/mim/internal/registry"
+)
+
+const (
+	// DiscoveryStatic balances over the configured Addrs
+	DiscoveryStatic = "static"
+	// DiscoveryRegistry balances over the logic servers in the registry
+	DiscoveryRegistry = "registry"
+
+	// BalanceRoundRobin spreads the requests evenly
+	BalanceRoundRobin = "round_robin"
+	// BalanceConsistentHash sends the requests of a user to the same server
+	// while it is healthy
+	BalanceConsistentHash = "consistent_hash"
+
+	defaultRegistryReload = 5 * time.Second
+)
+
+// Config of the logic connection
+type Config struct {
+	// Discovery is one of the Discovery constants
+	Discovery string
+	// Addrs of the logic servers with DiscoveryStatic
+	Addrs []string
+	// Balance is one of the Balance constants
+	Balance string
+	// RegistryReload in seconds the logic servers are reloaded from the
+	// registry with DiscoveryRegistry, 5 by default
+	RegistryReload int
+	// MaxRetries is the number of times an idempotent request is retried on
+	// another server when its server is unavailable
+	MaxRetries int
+}
+
+// Dial connects to the logic servers, it blocks until one of them is ready.
+// The redis pool is only used with DiscoveryRegistry.
+func Dial(conf *Config, pool *redis.Pool) (*grpc.ClientConn, error) {
+	var source func() ([]string, error)
+	reload := time.Duration(conf.RegistryReload) * time.Second
+	switch conf.Discovery {
+	case DiscoveryStatic, "":
+		addrs := conf.Addrs
+		source = func() ([]string, error) { return addrs, nil }
+	case DiscoveryRegistry:
+		if reload <= 0 {
+			reload = defaultRegistryReload
+		}
+		logics := registry.New(pool, "logic", 0)
+		source = func() ([]string, error) {
+			instances, err := logics.Instances()
+			if err != nil {
+				return nil, err
+			}
+			addrs := make([]string, 0, len(instances))
+			for _, addr := range instances {
+				addrs = append(addrs, addr)
+			}
+			return addrs, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown logic discovery %q", conf.Discovery)
+	}
+	balance := conf.Balance
+	if balance == "" {
+		balance = BalanceRoundRobin
+	}
+	if balance != BalanceRoundRobin && balance != BalanceConsistentHash {
+		return nil, fmt.Errorf("unknown logic balance %q", conf.Balance)
+	}
+	rb := &resolverBuilder{source: source, reload: reload}
+	serviceConfig := fmt.Sprintf(`{"loadBalancingConfig": [{%q: {}}], "healthCheckConfig": {"serviceName": ""}}`, balance)
+	return grpc.Dial(scheme+":///logic",
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithResolvers(rb),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: 5 * time.Second,
+		}),
+		grpc.WithUnaryInterceptor(failover(conf.MaxRetries)),
+	)
+}
@@ -0,0 +1,92 @@
+package lb
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"google.golang.org/grpc/resolver"
+)
+
+const scheme = "mim"
+
+// resolverBuilder resolves the logic servers from source, reloading them
+// every reload when it is not zero.
+type resolverBuilder struct {
+	source func() ([]string, error)
+	reload time.Duration
+}
+
+func (b *resolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	r := &logicResolver{
+		b:    b,
+		cc:   cc,
+		now:  make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	r.resolve()
+	go r.loop()
+	return r, nil
+}
+
+func (b *resolverBuilder) Scheme() string {
+	return scheme
+}
+
+type logicResolver struct {
+	b     *resolverBuilder
+	cc    resolver.ClientConn
+	addrs []string
+	now   chan struct{}
+	done  chan struct{}
+}
+
+func (r *logicResolver) loop() {
+	var tick <-chan time.Time
+	if r.b.reload > 0 {
+		ticker := time.NewTicker(r.b.reload)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-tick:
+		case <-r.now:
+		case <-r.done:
+			return
+		}
+		r.resolve()
+	}
+}
+
+// resolve updates the addresses of the client conn when they changed
+func (r *logicResolver) resolve() {
+	addrs, err := r.b.source()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	addrs = append([]string(nil), addrs...)
+	sort.Strings(addrs)
+	if r.addrs != nil && reflect.DeepEqual(addrs, r.addrs) {
+		return
+	}
+	r.addrs = addrs
+	state := resolver.State{Addresses: make([]resolver.Address, 0, len(addrs))}
+	for _, addr := range addrs {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr})
+	}
+	r.cc.UpdateState(state)
+}
+
+func (r *logicResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.now <- struct{}{}:
+	default:
+	}
+}
+
+func (r *logicResolver) Close() {
+	close(r.done)
+}
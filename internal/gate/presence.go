@@ -0,0 +1,141 @@
+package gate
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"github.com/RainJoe/mim/protocol"
+)
+
+const (
+	// offlineBacklog is the number of disconnected devices waiting to be
+	// reported, further ones are left to expire in logic.
+	offlineBacklog = 1024
+
+	// heartbeatBatch is the number of devices reported in one heartbeat.
+	heartbeatBatch = 1000
+)
+
+// snapshot returns the devices of the shard with a live connection, it runs
+// on the shard goroutine. Connections torn down but not unregistered yet are
+// left out, their leases expire in logic if the offline report is lost.
+func (s *hubShard) snapshot() []*pb.PresenceDevice {
+	devices := make([]*pb.PresenceDevice, 0, len(s.clients))
+	for uid, clients := range s.users {
+		for deviceID, client := range clients {
+			if client.isClosed() {
+				continue
+			}
+			devices = append(devices, &pb.PresenceDevice{Uid: uid, DeviceId: deviceID})
+		}
+	}
+	return devices
+}
+
+// reportOffline queues the device of the client to be reported offline
+// without blocking the shard.
+func (h *Hub) reportOffline(client *Client) {
+	uid, deviceID := client.identity()
+	select {
+	case h.offline <- &pb.PresenceDevice{Uid: uid, DeviceId: deviceID}:
+	default:
+	}
+}
+
+// ReportPresence sends the heartbeats of the gate to logic: the devices still
+// connected every period, and the devices that disconnected right away.
+// Logic considers devices offline that are not refreshed within its
+// PresenceTTL, period must be well below it.
+func (h *Hub) ReportPresence(logicService pb.LogicServiceClient, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			reply := make(chan []*pb.PresenceDevice, 1)
+			var online []*pb.PresenceDevice
+			for _, s := range h.shards {
+				s.devices <- reply
+				online = append(online, <-reply...)
+			}
+			for len(online) > 0 {
+				n := len(online)
+				if n > heartbeatBatch {
+					n = heartbeatBatch
+				}
+				heartbeat(logicService, &pb.HeartbeatRequest{GateId: h.id, Online: online[:n]})
+				online = online[n:]
+			}
+		case dev := <-h.offline:
+			offline := []*pb.PresenceDevice{dev}
+		drain:
+			for len(offline) < heartbeatBatch {
+				select {
+				case dev := <-h.offline:
+					offline = append(offline, dev)
+				default:
+					break drain
+				}
+			}
+			heartbeat(logicService, &pb.HeartbeatRequest{GateId: h.id, Offline: offline})
+		}
+	}
+}
+
+func heartbeat(logicService pb.LogicServiceClient, req *pb.HeartbeatRequest) {
+	req.Ts = time.Now().UnixNano() / 1e6
+	if _, err := logicService.Heartbeat(context.TODO(), req); err != nil {
+		log.Error(err)
+	}
+}
+
+func (c *Client) handleSetPresenceRequest(p *protocol.Packet) error {
+	req := pb.SetPresenceRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid, req.DeviceId = c.identity()
+	req.GateId = c.hub.id
+	rsp, err := c.logicService.SetPresence(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.SetPresenceResponseMessage, rsp)
+}
+
+func (c *Client) handleGetPresenceRequest(p *protocol.Packet) error {
+	req := pb.GetPresenceRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	rsp, err := c.logicService.GetPresence(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.GetPresenceResponseMessage, rsp)
+}
+
+func (c *Client) handleSubscribePresenceRequest(p *protocol.Packet) error {
+	req := pb.SubscribePresenceRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	req.Uid = c.userID()
+	if p.Header.Cmd == protocol.UnsubscribePresenceRequestMessage {
+		rsp, err := c.logicService.UnsubscribePresence(context.TODO(), &req)
+		if err != nil {
+			return err
+		}
+		return c.reply(protocol.UnsubscribePresenceResponseMessage, rsp)
+	}
+	rsp, err := c.logicService.SubscribePresence(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.SubscribePresenceResponseMessage, rsp)
+}
@@ -2,39 +2,145 @@ package gate
 
 import (
 	"context"
+	"io"
+	"time"
+
 	pb "github.com/RainJoe/mim/pb/push"
 	"github.com/RainJoe/mim/protocol"
-	"time"
 )
 
 type PushService struct {
-	gate *WebSocketGate
+	hub *Hub
 }
 
-func NewPushService(gate *WebSocketGate) *PushService {
-	return &PushService{gate}
+func NewPushService(hub *Hub) *PushService {
+	return &PushService{hub}
 }
 
-func (s* PushService) KickOut(ctx context.Context, req *pb.KickOutRequest) (*pb.KickOutResponse, error) {
+func (s *PushService) KickOut(ctx context.Context, req *pb.KickOutRequest) (*pb.KickOutResponse, error) {
 	rsp := &pb.KickOutResponse{
-		Ts: time.Now().Unix() / 1e6,
+		Ts: time.Now().UnixNano() / 1e6,
+	}
+	s.hub.push(&PushMessage{req.Uid, protocol.LogoutRequestMessage, req})
+	return rsp, nil
+}
+
+func (s *PushService) C2CPush(ctx context.Context, req *pb.C2CPushRequest) (*pb.Response, error) {
+	rsp := &pb.Response{
+		Ts: time.Now().UnixNano() / 1e6,
 	}
-	s.gate.hub.push <- &PushMessage{req.Uid, protocol.LogoutRequestMessage, req}
+	s.hub.push(&PushMessage{req.To, protocol.C2CPushRequestMessage, req})
 	return rsp, nil
+}
 
+func (s *PushService) C2GPush(ctx context.Context, req *pb.C2GPushRequest) (*pb.Response, error) {
+	rsp := &pb.Response{
+		Ts: time.Now().UnixNano() / 1e6,
+	}
+	s.hub.push(&PushMessage{req.To, protocol.C2GPushRequestMessage, req})
+	return rsp, nil
 }
-func(s* PushService) C2CPush(ctx context.Context, req *pb.C2CPushRequest) (*pb.Response, error) {
+
+// Recall pushes the recall notice to every recipient
+func (s *PushService) Recall(ctx context.Context, req *pb.RecallPushRequest) (*pb.Response, error) {
+	if req.Notice != nil {
+		for _, uid := range req.To {
+			s.hub.push(&PushMessage{uid, protocol.RecallPushRequestMessage, req.Notice})
+		}
+	}
 	rsp := &pb.Response{
-		Ts: time.Now().Unix() / 1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
 	}
-	s.gate.hub.push <- &PushMessage{req.To,protocol.C2CPushRequestMessage, req}
 	return rsp, nil
 }
 
-func(s* PushService) C2GPush(ctx context.Context, req *pb.C2GPushRequest) (*pb.Response, error) {
+func (s *PushService) ReadReceipt(ctx context.Context, req *pb.ReadReceipt) (*pb.Response, error) {
 	rsp := &pb.Response{
-		Ts: time.Now().Unix() / 1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
 	}
-	s.gate.hub.push <- &PushMessage{req.To,protocol.C2GPushRequestMessage, req}
+	s.hub.push(&PushMessage{req.To, protocol.ReadReceiptPushMessage, req})
 	return rsp, nil
-}
\ No newline at end of file
+}
+
+func (s *PushService) batchPush(req *pb.BatchPushRequest) {
+	if req.Msg == nil {
+		return
+	}
+	for _, uid := range req.To {
+		c2gPush := &pb.C2GPushRequest{
+			From:        req.Msg.From,
+			To:          uid,
+			Group:       req.Msg.Group,
+			Content:     req.Msg.Content,
+			MsgId:       req.Msg.MsgId,
+			Ts:          req.Msg.Ts,
+			Seq:         req.Msg.Seq,
+			ConvSeq:     req.Msg.ConvSeq,
+			MsgType:     req.Msg.MsgType,
+			ContentType: req.Msg.ContentType,
+			Payload:     req.Msg.Payload,
+		}
+		s.hub.push(&PushMessage{uid, protocol.C2GPushRequestMessage, c2gPush})
+	}
+}
+
+func (s *PushService) BatchPush(ctx context.Context, req *pb.BatchPushRequest) (*pb.Response, error) {
+	s.batchPush(req)
+	rsp := &pb.Response{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	return rsp, nil
+}
+
+// Push handles a stream of batch pushes, every request is answered with a
+// response carrying its seq.
+func (s *PushService) Push(stream pb.PushService_PushServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.batchPush(req)
+		rsp := &pb.Response{
+			Ts:  time.Now().UnixNano() / 1e6,
+			Seq: req.Seq,
+		}
+		if err := stream.Send(rsp); err != nil {
+			return err
+		}
+	}
+}
+
+// Presence pushes the presence change to every subscriber
+func (s *PushService) Presence(ctx context.Context, req *pb.PresencePushRequest) (*pb.Response, error) {
+	if req.Notice != nil {
+		for _, uid := range req.To {
+			s.hub.push(&PushMessage{uid, protocol.PresencePushMessage, req.Notice})
+		}
+	}
+	rsp := &pb.Response{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	return rsp, nil
+}
+
+// Signal pushes the ephemeral signal to every recipient
+func (s *PushService) Signal(ctx context.Context, req *pb.SignalPushRequest) (*pb.Response, error) {
+	if req.Notice != nil {
+		for _, uid := range req.To {
+			s.hub.push(&PushMessage{uid, protocol.SignalPushMessage, req.Notice})
+		}
+	}
+	rsp := &pb.Response{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	return rsp, nil
+}
@@ -0,0 +1,162 @@
+package gate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/RainJoe/mim/internal/gate/config"
+	pb "github.com/RainJoe/mim/pb/logic"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// TCPGate accepts raw tcp connections. The stream is framed by the 12 bytes
+// protocol.Header followed by the packet body.
+type TCPGate struct {
+	conf         *config.TCPGateConfig
+	logicService pb.LogicServiceClient
+	hub          *Hub
+	blobs        *BlobService
+	workers      *WorkerPool
+
+	mu        sync.Mutex
+	listener  net.Listener
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewTCPGate(conf *config.TCPGateConfig, hub *Hub, logicService pb.LogicServiceClient, blobs *BlobService, workers *WorkerPool) *TCPGate {
+	return &TCPGate{
+		conf:         conf,
+		logicService: logicService,
+		hub:          hub,
+		blobs:        blobs,
+		workers:      workers,
+		done:         make(chan struct{}),
+	}
+}
+
+// ListenAndServe listens on the configured address and serves the accepted
+// connections until Close is called.
+func (g *TCPGate) ListenAndServe() error {
+	lis, err := net.Listen("tcp", g.conf.Addr)
+	if err != nil {
+		return err
+	}
+	g.mu.Lock()
+	select {
+	case <-g.done:
+		g.mu.Unlock()
+		return lis.Close()
+	default:
+	}
+	g.listener = lis
+	g.mu.Unlock()
+	var tempDelay time.Duration
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			select {
+			case <-g.done:
+				return nil
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				if tempDelay == 0 {
+					tempDelay = 5 * time.Millisecond
+				} else {
+					tempDelay *= 2
+				}
+				if max := 1 * time.Second; tempDelay > max {
+					tempDelay = max
+				}
+				log.Errorf("Accept: %v; retrying in %v", err, tempDelay)
+				time.Sleep(tempDelay)
+				continue
+			}
+			return err
+		}
+		tempDelay = 0
+		g.serveConn(conn)
+	}
+}
+
+// Close stops accepting new connections, it may be called before
+// ListenAndServe started listening and more than once.
+func (g *TCPGate) Close() error {
+	var err error
+	g.closeOnce.Do(func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		close(g.done)
+		if g.listener != nil {
+			err = g.listener.Close()
+		}
+	})
+	return err
+}
+
+func (g *TCPGate) serveConn(conn net.Conn) {
+	if tc, ok := conn.(*net.TCPConn); ok {
+		tc.SetKeepAlive(true)
+		if g.conf.ReadBufferSize > 0 {
+			tc.SetReadBuffer(g.conf.ReadBufferSize)
+		}
+		if g.conf.WriteBufferSize > 0 {
+			tc.SetWriteBuffer(g.conf.WriteBufferSize)
+		}
+	}
+	newClient(newTCPConn(conn), g.hub, g.logicService, g.blobs, g.workers, sendOptions{g.conf.SendQueue, g.conf.SlowConsumer}).serve(time.Duration(g.conf.AuthTimeout) * time.Second)
+}
+
+// tcpConn reads and writes packed packets on a tcp stream. There are no
+// pings, clients keep the connection alive by sending heartbeats.
+type tcpConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newTCPConn(conn net.Conn) *tcpConn {
+	return &tcpConn{conn: conn, r: bufio.NewReader(conn)}
+}
+
+func (c *tcpConn) ReadMessage() ([]byte, error) {
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	header := make([]byte, protocol.HeaderLength)
+	if _, err := io.ReadFull(c.r, header); err != nil {
+		return nil, err
+	}
+	var h protocol.Header
+	if err := binary.Read(bytes.NewReader(header), binary.BigEndian, &h); err != nil {
+		return nil, err
+	}
+	if h.BodyLen > maxMessageSize-protocol.HeaderLength {
+		return nil, fmt.Errorf("packet body too large: %d", h.BodyLen)
+	}
+	message := make([]byte, protocol.HeaderLength+int(h.BodyLen))
+	copy(message, header)
+	if _, err := io.ReadFull(c.r, message[protocol.HeaderLength:]); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func (c *tcpConn) WriteMessage(data []byte) error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	_, err := c.conn.Write(data)
+	return err
+}
+
+func (c *tcpConn) Ping() error {
+	return nil
+}
+
+func (c *tcpConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,153 @@
+package gate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/RainJoe/mim/internal/gate/config"
+	pb "github.com/RainJoe/mim/pb/logic"
+	"github.com/RainJoe/mim/protocol"
+)
+
+func packPacket(t *testing.T, cmd uint32, seq int64) []byte {
+	t.Helper()
+	p, err := protocol.NewPacket(protocol.V1, cmd, &pb.C2CSendRequest{To: "bob", Content: "hello", Seq: seq})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := p.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// writeChunks writes the stream to the peer in the given pieces and closes
+// it.
+func writeChunks(peer net.Conn, stream []byte, sizes ...int) {
+	go func() {
+		defer peer.Close()
+		for _, n := range sizes {
+			if n > len(stream) {
+				n = len(stream)
+			}
+			if _, err := peer.Write(stream[:n]); err != nil {
+				return
+			}
+			stream = stream[n:]
+		}
+		peer.Write(stream)
+	}()
+}
+
+func TestTCPConnFraming(t *testing.T) {
+	first := packPacket(t, protocol.C2CSendRequestMessage, 1)
+	second := packPacket(t, protocol.HeartBeatRequestMessage, 2)
+	stream := append(append([]byte(nil), first...), second...)
+	bytewise := make([]int, len(stream))
+	for i := range bytewise {
+		bytewise[i] = 1
+	}
+	tests := []struct {
+		name  string
+		sizes []int
+	}{
+		{"both packets at once", nil},
+		{"byte by byte", bytewise},
+		{"split header", []int{5, 7}},
+		{"split body", []int{protocol.HeaderLength + 3}},
+		{"second header with the first body", []int{len(first) + 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			c := newTCPConn(server)
+			defer c.Close()
+			writeChunks(client, stream, tt.sizes...)
+			for _, want := range [][]byte{first, second} {
+				got, err := c.ReadMessage()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Fatalf("read %x, want %x", got, want)
+				}
+			}
+			if _, err := c.ReadMessage(); err != io.EOF {
+				t.Errorf("read after the last packet returned %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestTCPConnTruncated(t *testing.T) {
+	packet := packPacket(t, protocol.C2CSendRequestMessage, 1)
+	for _, n := range []int{protocol.HeaderLength - 1, len(packet) - 1} {
+		server, client := net.Pipe()
+		c := newTCPConn(server)
+		writeChunks(client, packet[:n])
+		if _, err := c.ReadMessage(); err != io.ErrUnexpectedEOF {
+			t.Errorf("read of %d of %d bytes returned %v, want io.ErrUnexpectedEOF", n, len(packet), err)
+		}
+		c.Close()
+	}
+}
+
+func TestTCPConnTooLarge(t *testing.T) {
+	header := protocol.NewHeader(protocol.V1, protocol.C2CSendRequestMessage)
+	header.BodyLen = maxMessageSize
+	buf := bytes.Buffer{}
+	if err := binary.Write(&buf, binary.BigEndian, &header); err != nil {
+		t.Fatal(err)
+	}
+	server, client := net.Pipe()
+	c := newTCPConn(server)
+	defer c.Close()
+	writeChunks(client, buf.Bytes())
+	if _, err := c.ReadMessage(); err == nil {
+		t.Error("packet larger than maxMessageSize accepted")
+	}
+}
+
+func TestTCPConnWrite(t *testing.T) {
+	packet := packPacket(t, protocol.C2CSendResponseMessage, 1)
+	server, client := net.Pipe()
+	c := newTCPConn(server)
+	defer c.Close()
+	go c.WriteMessage(packet)
+	got := make([]byte, len(packet))
+	if _, err := io.ReadFull(client, got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, packet) {
+		t.Errorf("wrote %x, want %x", got, packet)
+	}
+}
+
+func TestTCPGateClose(t *testing.T) {
+	for _, delay := range []time.Duration{0, 10 * time.Millisecond} {
+		g := NewTCPGate(&config.TCPGateConfig{Addr: "127.0.0.1:0"}, nil, nil, nil, nil)
+		served := make(chan error, 1)
+		go func() { served <- g.ListenAndServe() }()
+		// closed before or after the listener is set up
+		time.Sleep(delay)
+		if err := g.Close(); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case err := <-served:
+			if err != nil {
+				t.Errorf("ListenAndServe() returned %v after Close", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("ListenAndServe did not return after Close")
+		}
+		if err := g.Close(); err != nil {
+			t.Errorf("second Close() returned %v", err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package gate
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/RainJoe/mim/internal/gate/blob"
+	pbblob "github.com/RainJoe/mim/pb/blob"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// defaultChunkSize leaves room for the packet header and the other fields of
+// a chunk within maxMessageSize
+const defaultChunkSize = 32 * 1024
+
+// BlobService serves the uploads and downloads of clients from the blob
+// store, a nil service or store answers every request with DISABLED.
+type BlobService struct {
+	store     blob.Store
+	chunkSize int
+}
+
+func NewBlobService(conf *blob.Config) (*BlobService, error) {
+	store, err := blob.New(conf)
+	if err != nil {
+		return nil, err
+	}
+	chunkSize := conf.ChunkSize
+	if chunkSize <= 0 || chunkSize > defaultChunkSize {
+		chunkSize = defaultChunkSize
+	}
+	return &BlobService{store: store, chunkSize: chunkSize}, nil
+}
+
+func (s *BlobService) disabled() bool {
+	return s == nil || s.store == nil
+}
+
+// blobStatus maps the errors of the store to a status, other errors are
+// returned
+func blobStatus(err error) (pbblob.Status, error) {
+	switch err {
+	case nil:
+		return pbblob.Status_SUCCESS, nil
+	case blob.ErrNotFound:
+		return pbblob.Status_NOT_FOUND, nil
+	case blob.ErrTooLarge:
+		return pbblob.Status_TOO_LARGE, nil
+	case blob.ErrQuotaExceeded:
+		return pbblob.Status_QUOTA_EXCEEDED, nil
+	case blob.ErrBadChecksum:
+		return pbblob.Status_BAD_CHECKSUM, nil
+	case blob.ErrBadOffset:
+		return pbblob.Status_BAD_OFFSET, nil
+	case blob.ErrIncomplete:
+		return pbblob.Status_INCOMPLETE, nil
+	}
+	return 0, err
+}
+
+func (s *BlobService) Begin(uid string, req *pbblob.UploadBeginRequest) (*pbblob.UploadBeginResponse, error) {
+	rsp := &pbblob.UploadBeginResponse{Seq: req.Seq}
+	if s.disabled() {
+		rsp.Status = pbblob.Status_DISABLED
+		return rsp, nil
+	}
+	rsp.ChunkSize = int32(s.chunkSize)
+	// the content is always uploaded, a blob id is no proof of having the
+	// content and downloads are only checked for a session. The store keeps
+	// a single copy of the same content on commit.
+	uploadID, err := s.store.Begin(uid, req.Size, req.MimeType)
+	if rsp.Status, err = blobStatus(err); err != nil {
+		return nil, err
+	}
+	rsp.UploadId = uploadID
+	return rsp, nil
+}
+
+func (s *BlobService) Chunk(uid string, req *pbblob.UploadChunkRequest) (*pbblob.UploadChunkResponse, error) {
+	rsp := &pbblob.UploadChunkResponse{Seq: req.Seq}
+	if s.disabled() {
+		rsp.Status = pbblob.Status_DISABLED
+		return rsp, nil
+	}
+	if len(req.Data) > s.chunkSize {
+		rsp.Status = pbblob.Status_TOO_LARGE
+		return rsp, nil
+	}
+	received, err := s.store.WriteChunk(uid, req.UploadId, req.Offset, req.Data)
+	if rsp.Status, err = blobStatus(err); err != nil {
+		return nil, err
+	}
+	rsp.Received = received
+	return rsp, nil
+}
+
+func (s *BlobService) Commit(uid string, req *pbblob.UploadCommitRequest) (*pbblob.UploadCommitResponse, error) {
+	rsp := &pbblob.UploadCommitResponse{Seq: req.Seq}
+	if s.disabled() {
+		rsp.Status = pbblob.Status_DISABLED
+		return rsp, nil
+	}
+	info, err := s.store.Commit(uid, req.UploadId, req.Checksum)
+	if rsp.Status, err = blobStatus(err); err != nil {
+		return nil, err
+	}
+	if info != nil {
+		rsp.BlobId = info.ID
+		rsp.Size = info.Size
+	}
+	return rsp, nil
+}
+
+func (s *BlobService) Download(req *pbblob.DownloadRequest) (*pbblob.DownloadResponse, error) {
+	rsp := &pbblob.DownloadResponse{BlobId: req.BlobId, Offset: req.Offset, Seq: req.Seq}
+	if s.disabled() {
+		rsp.Status = pbblob.Status_DISABLED
+		return rsp, nil
+	}
+	b, info, err := s.store.Open(req.BlobId)
+	if rsp.Status, err = blobStatus(err); err != nil {
+		return nil, err
+	}
+	if rsp.Status != pbblob.Status_SUCCESS {
+		return rsp, nil
+	}
+	defer b.Close()
+	rsp.Size = info.Size
+	rsp.MimeType = info.MimeType
+	length := int(req.Length)
+	if length <= 0 || length > s.chunkSize {
+		length = s.chunkSize
+	}
+	if req.Offset < 0 || req.Offset > info.Size {
+		rsp.Status = pbblob.Status_BAD_OFFSET
+		return rsp, nil
+	}
+	if _, err := b.Seek(req.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	n, err := io.ReadFull(b, data)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	rsp.Data = data[:n]
+	return rsp, nil
+}
+
+func (c *Client) handleUploadBeginRequest(p *protocol.Packet) error {
+	req := pbblob.UploadBeginRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	rsp, err := c.blobs.Begin(c.userID(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.UploadBeginResponseMessage, rsp)
+}
+
+func (c *Client) handleUploadChunkRequest(p *protocol.Packet) error {
+	req := pbblob.UploadChunkRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	rsp, err := c.blobs.Chunk(c.userID(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.UploadChunkResponseMessage, rsp)
+}
+
+func (c *Client) handleUploadCommitRequest(p *protocol.Packet) error {
+	req := pbblob.UploadCommitRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	rsp, err := c.blobs.Commit(c.userID(), &req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.UploadCommitResponseMessage, rsp)
+}
+
+func (c *Client) handleDownloadRequest(p *protocol.Packet) error {
+	req := pbblob.DownloadRequest{}
+	if err := proto.Unmarshal(p.Body(), &req); err != nil {
+		return err
+	}
+	rsp, err := c.blobs.Download(&req)
+	if err != nil {
+		return err
+	}
+	return c.reply(protocol.DownloadResponseMessage, rsp)
+}
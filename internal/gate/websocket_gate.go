@@ -1,21 +1,27 @@
 package gate
 
 import (
-	pb "github.com/RainJoe/mim/pb/logic"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/RainJoe/mim/internal/gate/config"
+	pb "github.com/RainJoe/mim/pb/logic"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	"github.com/gorilla/websocket"
 )
 
 type WebSocketGate struct {
-	upgrade *websocket.Upgrader
+	upgrade      *websocket.Upgrader
 	logicService pb.LogicServiceClient
-	hub *Hub
+	hub          *Hub
+	blobs        *BlobService
+	workers      *WorkerPool
+	authTimeout  time.Duration
+	send         sendOptions
 }
 
-func NewWebSocketGate(conf *config.WebSocketGateConfig, logicService pb.LogicServiceClient) *WebSocketGate {
+func NewWebSocketGate(conf *config.WebSocketGateConfig, hub *Hub, logicService pb.LogicServiceClient, blobs *BlobService, workers *WorkerPool) *WebSocketGate {
 	return &WebSocketGate{
 		upgrade: &websocket.Upgrader{
 			ReadBufferSize:  conf.ReadBufferSize,
@@ -25,23 +31,60 @@ func NewWebSocketGate(conf *config.WebSocketGateConfig, logicService pb.LogicSer
 			},
 		},
 		logicService: logicService,
-		hub: newHub(),
+		hub:          hub,
+		blobs:        blobs,
+		workers:      workers,
+		authTimeout:  time.Duration(conf.AuthTimeout) * time.Second,
+		send:         sendOptions{conf.SendQueue, conf.SlowConsumer},
 	}
 }
 
-func (ws *WebSocketGate) InitHub() {
-	ws.hub.run()
-}
-
 // serveWs handles websocket requests from the peer.
 func (ws *WebSocketGate) ServeWs(w http.ResponseWriter, r *http.Request) {
 	conn, err := ws.upgrade.Upgrade(w, r, nil)
 	if err != nil {
-		log.Errorf("Upgrade: ", err)
+		log.Errorf("Upgrade: %v", err)
+		return
+	}
+	newClient(newWsConn(conn), ws.hub, ws.logicService, ws.blobs, ws.workers, ws.send).serve(ws.authTimeout)
+}
+
+// wsConn carries packets in binary websocket messages.
+type wsConn struct {
+	conn *websocket.Conn
+}
+
+func newWsConn(conn *websocket.Conn) *wsConn {
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error { conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
+	return &wsConn{conn: conn}
+}
+
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	_, message, err := c.conn.ReadMessage()
+	if err != nil {
+		if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+			return nil, err
+		}
+		return nil, io.EOF
 	}
-	client := &Client{conn: conn, send: make(chan []byte, 256), logicService: ws.logicService, hub: ws.hub}
-	// Allow collection of memory referenced by the caller by doing all work in
-	// new goroutines.
-	go client.writePump()
-	go client.readPump()
+	return message, nil
+}
+
+func (c *wsConn) WriteMessage(data []byte) error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func (c *wsConn) Ping() error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.conn.WriteMessage(websocket.PingMessage, nil)
+}
+
+// Close sends a close frame to the peer and closes the underlying connection.
+// WriteControl may be called concurrently with the write pump.
+func (c *wsConn) Close() error {
+	c.conn.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(writeWait))
+	return c.conn.Close()
 }
@@ -0,0 +1,75 @@
+package gate
+
+import (
+	"sync/atomic"
+
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// WorkerPool processes the requests of the clients with a bounded number of
+// goroutines. The requests of a client are processed one at a time in the
+// order they were read, the requests of different clients concurrently.
+type WorkerPool struct {
+	// Clients with queued requests and no worker processing them.
+	ready chan *Client
+
+	// Number of requests queued on each client.
+	queueSize int
+}
+
+// NewWorkerPool starts workers goroutines, each client queues at most
+// queueSize requests.
+func NewWorkerPool(workers int, queueSize int) *WorkerPool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	wp := &WorkerPool{
+		ready:     make(chan *Client, workers),
+		queueSize: queueSize,
+	}
+	for i := 0; i < workers; i++ {
+		go wp.work()
+	}
+	return wp
+}
+
+// enqueue queues the request of the client and hands the client to a worker
+// unless one is already processing it. It blocks while the queue of the
+// client is full or all workers are busy, so the reader stops reading from
+// the connection.
+func (wp *WorkerPool) enqueue(c *Client, p *protocol.Packet) {
+	c.inbox <- p
+	if atomic.CompareAndSwapInt32(&c.scheduled, 0, 1) {
+		wp.ready <- c
+	}
+}
+
+func (wp *WorkerPool) work() {
+	for c := range wp.ready {
+		wp.drain(c)
+	}
+}
+
+// drain processes the queued requests of the client until its queue is
+// empty. A request queued after the queue was found empty but before the
+// client was released is picked up by the second check.
+func (wp *WorkerPool) drain(c *Client) {
+	for {
+		select {
+		case p := <-c.inbox:
+			if err := c.dispatchPacket(p); err != nil {
+				log.Error(err)
+			}
+			continue
+		default:
+		}
+		atomic.StoreInt32(&c.scheduled, 0)
+		if len(c.inbox) == 0 || !atomic.CompareAndSwapInt32(&c.scheduled, 0, 1) {
+			return
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package gate
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	"github.com/RainJoe/mim/protocol"
+)
+
+// testConn discards what is written to it, reads block until it is closed.
+type testConn struct {
+	written   int64
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newTestConn() *testConn {
+	return &testConn{closed: make(chan struct{})}
+}
+
+func (c *testConn) ReadMessage() ([]byte, error) {
+	<-c.closed
+	return nil, io.EOF
+}
+
+func (c *testConn) WriteMessage(data []byte) error {
+	atomic.AddInt64(&c.written, 1)
+	return nil
+}
+
+func (c *testConn) Ping() error {
+	return nil
+}
+
+func (c *testConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+// sendLogic records the sends of each user, the calls of a user must never
+// overlap.
+type sendLogic struct {
+	pb.LogicServiceClient
+	t *testing.T
+	// closed to let blocked sends return, nil if sends do not block
+	release chan struct{}
+
+	mu     sync.Mutex
+	seqs   map[string][]int64
+	active map[string]bool
+}
+
+func (l *sendLogic) C2CSend(ctx context.Context, in *pb.C2CSendRequest, opts ...grpc.CallOption) (*pb.C2CSendResponse, error) {
+	l.mu.Lock()
+	if l.active[in.From] {
+		l.t.Errorf("requests of %s processed concurrently", in.From)
+	}
+	l.active[in.From] = true
+	l.mu.Unlock()
+	if l.release != nil {
+		<-l.release
+	} else if in.Seq%7 == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	l.mu.Lock()
+	l.active[in.From] = false
+	l.seqs[in.From] = append(l.seqs[in.From], in.Seq)
+	l.mu.Unlock()
+	return &pb.C2CSendResponse{Seq: in.Seq}, nil
+}
+
+// sent returns the seqs of the sends of the user processed so far.
+func (l *sendLogic) sent(uid string) []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]int64(nil), l.seqs[uid]...)
+}
+
+func newSendLogic(t *testing.T) *sendLogic {
+	return &sendLogic{t: t, seqs: make(map[string][]int64), active: make(map[string]bool)}
+}
+
+func c2cSendPacket(t *testing.T, seq int64) *protocol.Packet {
+	t.Helper()
+	p, err := protocol.NewPacket(protocol.V1, protocol.C2CSendRequestMessage, &pb.C2CSendRequest{To: "bob", Seq: seq})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := p.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the body is only set when a packet is read
+	p, err = parseMessage(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func newTestClient(logic pb.LogicServiceClient, workers *WorkerPool, uid string) *Client {
+	c := newClient(newTestConn(), NewHub("test", 1), logic, nil, workers, sendOptions{})
+	c.authenticate(uid, "device", "test")
+	go c.writePump()
+	return c
+}
+
+func TestWorkerPoolOrder(t *testing.T) {
+	const clients, requests = 20, 100
+	logic := newSendLogic(t)
+	workers := NewWorkerPool(4, 8)
+	var wg sync.WaitGroup
+	for i := 0; i < clients; i++ {
+		c := newTestClient(logic, workers, strconv.Itoa(i))
+		wg.Add(1)
+		// each client is read by its own goroutine like by readPump
+		go func() {
+			defer wg.Done()
+			for seq := int64(1); seq <= requests; seq++ {
+				workers.enqueue(c, c2cSendPacket(t, seq))
+			}
+		}()
+	}
+	wg.Wait()
+	deadline := time.Now().Add(5 * time.Second)
+	for i := 0; i < clients; i++ {
+		uid := strconv.Itoa(i)
+		for len(logic.sent(uid)) < requests {
+			if time.Now().After(deadline) {
+				t.Fatalf("%d of %d requests of %s processed", len(logic.sent(uid)), requests, uid)
+			}
+			time.Sleep(time.Millisecond)
+		}
+		for j, seq := range logic.sent(uid) {
+			if seq != int64(j+1) {
+				t.Fatalf("request %d of %s processed as %d", seq, uid, j+1)
+			}
+		}
+	}
+}
+
+func TestWorkerPoolBackpressure(t *testing.T) {
+	const queueSize = 2
+	logic := newSendLogic(t)
+	logic.release = make(chan struct{})
+	workers := NewWorkerPool(1, queueSize)
+	c := newTestClient(logic, workers, "alice")
+	// the first request occupies the worker, the next ones fill the queue
+	for seq := int64(1); seq <= queueSize+1; seq++ {
+		workers.enqueue(c, c2cSendPacket(t, seq))
+	}
+	blocked := make(chan struct{})
+	go func() {
+		workers.enqueue(c, c2cSendPacket(t, queueSize+2))
+		close(blocked)
+	}()
+	select {
+	case <-blocked:
+		t.Fatal("enqueue to a full queue returned")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(logic.release)
+	select {
+	case <-blocked:
+	case <-time.After(time.Second):
+		t.Fatal("enqueue still blocked after the queue drained")
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(logic.sent("alice")) < queueSize+2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d requests processed, want %d", len(logic.sent("alice")), queueSize+2)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
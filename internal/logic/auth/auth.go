@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTokenExpired the token is expired
+	ErrTokenExpired = errors.New("token expired")
+	// ErrBadSignature the signature of the token can not be verified
+	ErrBadSignature = errors.New("token signature invalid")
+	// ErrTokenInvalid the token is malformed or issued for another user
+	ErrTokenInvalid = errors.New("token invalid")
+)
+
+// Verifier verifies the login token a user presents when connecting
+type Verifier interface {
+	// Verify returns nil if the token is valid for the user, otherwise one
+	// of ErrTokenExpired, ErrBadSignature or ErrTokenInvalid
+	Verify(uid string, token string) error
+}
+
+// Config def
+type Config struct {
+	// Verifier is "jwt", or "none" to accept any token
+	Verifier string
+	// Secret is the HMAC key of HS256/HS384/HS512 tokens
+	Secret string
+	// PublicKeyFile is a PEM encoded RSA public key of RS256/RS384/RS512 tokens
+	PublicKeyFile string
+	// JWKSFile is a local JSON Web Key Set, keys are selected by the kid header
+	JWKSFile string
+	// UIDClaim is the claim holding the user id, "sub" by default
+	UIDClaim string
+	// Leeway in seconds allowed for clock skew when checking expiry
+	Leeway int64
+}
+
+// New verifier from config
+func New(c *Config) (Verifier, error) {
+	switch c.Verifier {
+	case "none":
+		return noneVerifier{}, nil
+	case "", "jwt":
+		return newJWTVerifier(c)
+	}
+	return nil, fmt.Errorf("unknown token verifier %q", c.Verifier)
+}
+
+// noneVerifier trusts any token, only meant for development
+type noneVerifier struct{}
+
+func (noneVerifier) Verify(uid string, token string) error {
+	return nil
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type jwtVerifier struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	keys      map[string]interface{}
+	uidClaim  string
+	leeway    int64
+}
+
+func newJWTVerifier(c *Config) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		uidClaim: c.UIDClaim,
+		leeway:   c.Leeway,
+	}
+	if v.uidClaim == "" {
+		v.uidClaim = "sub"
+	}
+	if c.Secret != "" {
+		v.secret = []byte(c.Secret)
+	}
+	if c.PublicKeyFile != "" {
+		b, err := ioutil.ReadFile(c.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if v.publicKey, err = jwt.ParseRSAPublicKeyFromPEM(b); err != nil {
+			return nil, err
+		}
+	}
+	if c.JWKSFile != "" {
+		b, err := ioutil.ReadFile(c.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		if v.keys, err = parseJWKS(b); err != nil {
+			return nil, err
+		}
+	}
+	if v.secret == nil && v.publicKey == nil && len(v.keys) == 0 {
+		return nil, errors.New("jwt verifier needs a Secret, PublicKeyFile or JWKSFile")
+	}
+	return v, nil
+}
+
+// key returns the verification key of the token. Keys are only handed out
+// for the algorithm family they belong to, so an RSA public key is never used
+// as an HMAC secret.
+func (v *jwtVerifier) key(token *jwt.Token) (interface{}, error) {
+	if kid, ok := token.Header["kid"].(string); ok && v.keys != nil {
+		key, ok := v.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if b, ok := key.([]byte); ok {
+				return b, nil
+			}
+		case *jwt.SigningMethodRSA:
+			if k, ok := key.(*rsa.PublicKey); ok {
+				return k, nil
+			}
+		}
+		return nil, fmt.Errorf("key %q does not match algorithm %s", kid, token.Method.Alg())
+	}
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if v.secret != nil {
+			return v.secret, nil
+		}
+	case *jwt.SigningMethodRSA:
+		if v.publicKey != nil {
+			return v.publicKey, nil
+		}
+	}
+	return nil, fmt.Errorf("no key for algorithm %s", token.Method.Alg())
+}
+
+func (v *jwtVerifier) Verify(uid string, tokenString string) error {
+	parser := jwt.Parser{SkipClaimsValidation: true}
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(tokenString, claims, v.key)
+	if err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors&(jwt.ValidationErrorSignatureInvalid|jwt.ValidationErrorUnverifiable) != 0 {
+			return ErrBadSignature
+		}
+		return ErrTokenInvalid
+	}
+	// claims are checked here instead of by the parser to apply the leeway
+	// and to require an expiry
+	now := time.Now().Unix()
+	if _, ok := claims["exp"]; !ok {
+		return ErrTokenInvalid
+	}
+	if !claims.VerifyExpiresAt(now-v.leeway, true) {
+		return ErrTokenExpired
+	}
+	if !claims.VerifyNotBefore(now+v.leeway, false) {
+		return ErrTokenInvalid
+	}
+	if sub, ok := claims[v.uidClaim].(string); !ok || sub != uid {
+		return ErrTokenInvalid
+	}
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// parseJWKS parses the RSA and symmetric keys of a JSON Web Key Set
+func parseJWKS(b []byte) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, k := range set.Keys {
+		switch k.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
+			if err != nil {
+				return nil, err
+			}
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil {
+				return nil, err
+			}
+			keys[k.Kid] = &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			}
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil {
+				return nil, err
+			}
+			keys[k.Kid] = secret
+		}
+	}
+	return keys, nil
+}
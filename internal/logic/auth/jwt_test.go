@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const testSecret = "secret"
+
+type testKeys struct {
+	dir       string
+	rsa       *rsa.PrivateKey
+	other     *rsa.PrivateKey
+	publicPEM []byte
+	pemFile   string
+	jwksFile  string
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &testKeys{dir: dir}
+	for _, key := range []**rsa.PrivateKey{&k.rsa, &k.other} {
+		if *key, err = rsa.GenerateKey(rand.Reader, 1024); err != nil {
+			t.Fatal(err)
+		}
+	}
+	der, err := x509.MarshalPKIXPublicKey(&k.rsa.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.publicPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	k.pemFile = filepath.Join(dir, "public.pem")
+	if err := ioutil.WriteFile(k.pemFile, k.publicPEM, 0644); err != nil {
+		t.Fatal(err)
+	}
+	b64 := base64.RawURLEncoding.EncodeToString
+	jwks := fmt.Sprintf(`{"keys": [
+		{"kty": "oct", "kid": "hmac", "k": %q},
+		{"kty": "RSA", "kid": "rsa", "n": %q, "e": %q}
+	]}`, b64([]byte(testSecret)), b64(k.rsa.N.Bytes()), b64([]byte{1, 0, 1}))
+	k.jwksFile = filepath.Join(dir, "jwks.json")
+	if err := ioutil.WriteFile(k.jwksFile, []byte(jwks), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestJWTVerify(t *testing.T) {
+	keys := newTestKeys(t)
+	defer os.RemoveAll(keys.dir)
+	now := time.Now().Unix()
+	valid := jwt.MapClaims{"sub": "alice", "exp": now + 60}
+	tests := []struct {
+		name  string
+		conf  Config
+		uid   string
+		token string
+		err   error
+	}{
+		{
+			name:  "hmac",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", valid),
+		},
+		{
+			name:  "rsa",
+			conf:  Config{PublicKeyFile: keys.pemFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa, "", valid),
+		},
+		{
+			name:  "expired",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice", "exp": now - 10}),
+			err:   ErrTokenExpired,
+		},
+		{
+			name:  "expired within leeway",
+			conf:  Config{Secret: testSecret, Leeway: 30},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice", "exp": now - 10}),
+		},
+		{
+			name:  "expired beyond leeway",
+			conf:  Config{Secret: testSecret, Leeway: 30},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice", "exp": now - 60}),
+			err:   ErrTokenExpired,
+		},
+		{
+			name:  "no expiry",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice"}),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "not valid yet",
+			conf:  Config{Secret: testSecret, Leeway: 30},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice", "exp": now + 600, "nbf": now + 60}),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "not before within leeway",
+			conf:  Config{Secret: testSecret, Leeway: 30},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "alice", "exp": now + 600, "nbf": now + 10}),
+		},
+		{
+			name:  "bad hmac signature",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte("other"), "", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "bad rsa signature",
+			conf:  Config{PublicKeyFile: keys.pemFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodRS256, keys.other, "", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "hmac signed with the rsa public key",
+			conf:  Config{PublicKeyFile: keys.pemFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, keys.publicPEM, "", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "rsa without public key",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa, "", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "unsigned",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "uid mismatch",
+			conf:  Config{Secret: testSecret},
+			uid:   "bob",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", valid),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "uid claim",
+			conf:  Config{Secret: testSecret, UIDClaim: "uid"},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", jwt.MapClaims{"sub": "bob", "uid": "alice", "exp": now + 60}),
+		},
+		{
+			name:  "uid claim missing",
+			conf:  Config{Secret: testSecret, UIDClaim: "uid"},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", valid),
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "malformed",
+			conf:  Config{Secret: testSecret},
+			uid:   "alice",
+			token: "not.a.token",
+			err:   ErrTokenInvalid,
+		},
+		{
+			name:  "jwks hmac",
+			conf:  Config{JWKSFile: keys.jwksFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "hmac", valid),
+		},
+		{
+			name:  "jwks rsa",
+			conf:  Config{JWKSFile: keys.jwksFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", valid),
+		},
+		{
+			name:  "jwks unknown kid",
+			conf:  Config{JWKSFile: keys.jwksFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "unknown", valid),
+			err:   ErrBadSignature,
+		},
+		{
+			name:  "jwks kid of another algorithm family",
+			conf:  Config{JWKSFile: keys.jwksFile},
+			uid:   "alice",
+			token: sign(t, jwt.SigningMethodHS256, keys.publicPEM, "rsa", valid),
+			err:   ErrBadSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := newJWTVerifier(&tt.conf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := v.Verify(tt.uid, tt.token); err != tt.err {
+				t.Errorf("Verify() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestNewJWTVerifierNeedsKey(t *testing.T) {
+	if _, err := newJWTVerifier(&Config{}); err == nil {
+		t.Error("verifier without keys created")
+	}
+}
@@ -3,33 +3,108 @@ package config
 import (
 	"flag"
 	"github.com/BurntSushi/toml"
+	"github.com/RainJoe/mim/internal/logic/auth"
 	"github.com/RainJoe/mim/pkg/pg"
 	"github.com/RainJoe/mim/pkg/redis"
+	"net"
+	"os"
 )
 
 var (
-	Conf *Config
+	Conf    *Config
 	cfgPath string
 )
 
 type Config struct {
 	LogicServer LogicServerConfig `toml:"logicServer"`
-	Pg    pg.Config   `toml:"pg"`
-	Redis redis.Config `toml:"redis"`
+	Pg          pg.Config         `toml:"pg"`
+	Redis       redis.Config      `toml:"redis"`
+	Auth        auth.Config       `toml:"auth"`
 }
 
 type LogicServerConfig struct {
-	Addr            string
-	PushServerAddr  string
+	Addr string
+	// ID of the logic server in the logic registry, AdvertiseAddr by default
+	ID string
+	// AdvertiseAddr is the address gates dial, the host name with the port
+	// of Addr by default
+	AdvertiseAddr string
+	// RegistryTTL in seconds the registration of the logic server lives
+	// without a refresh, 0 disables the registration
+	RegistryTTL int
+	// RegistryReload in seconds the gates are reloaded from the registry
+	RegistryReload int
+	// DevicePolicy decides which sessions are kicked out when a user logs
+	// in on another device, one of the DevicePolicy constants
+	DevicePolicy string
+	// MaxDevices is the number of devices a user may be logged in on with
+	// the DevicePolicyOldest policy
+	MaxDevices int
+	// PushIdleTimeout in seconds, connections to gates that were not pushed
+	// to in this time are closed
+	PushIdleTimeout int
+	// PushBatchSize is the number of group members pushed in one batch
+	PushBatchSize int
+	// AckTimeout in seconds, pushed messages not acked in this time are
+	// pushed again
+	AckTimeout int
+	// MaxRetries is the number of redeliveries before a message is left for
+	// the offline pull
+	MaxRetries int
+	// DedupTTL in seconds the ids of sent messages are cached to answer
+	// resent messages
+	DedupTTL int
+	// MaxPayloadSize in bytes of the payload of a message
+	MaxPayloadSize int
+	// RecallWindow in seconds a message can be recalled after it was sent,
+	// 0 means no limit
+	RecallWindow int
+	// SignalRate is the number of signals a user may send per second, 0
+	// means no limit
+	SignalRate int
+	// PresenceTTL in seconds a device stays online without a heartbeat of
+	// its gate
+	PresenceTTL int
+	// MaxPresenceSubscriptions is the number of users a user may subscribe
+	// to the presence of, 0 means no limit
+	MaxPresenceSubscriptions int
+	// DebugAddr serves expvar stats on /debug/vars, disabled when empty
+	DebugAddr string
+}
+
+const (
+	// DevicePolicyAll allows any number of devices
+	DevicePolicyAll = "all"
+	// DevicePolicyPlatform allows one device per platform
+	DevicePolicyPlatform = "platform"
+	// DevicePolicyOldest kicks out the oldest devices beyond MaxDevices
+	DevicePolicyOldest = "oldest"
+)
+
+// ServerID returns the id the logic server registers under
+func (c *LogicServerConfig) ServerID() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	return c.Advertise()
+}
+
+// Advertise returns the address the logic server registers
+func (c *LogicServerConfig) Advertise() string {
+	if c.AdvertiseAddr != "" {
+		return c.AdvertiseAddr
+	}
+	host, _ := os.Hostname()
+	_, port, _ := net.SplitHostPort(c.Addr)
+	return net.JoinHostPort(host, port)
 }
 
 func init() {
 	flag.StringVar(&cfgPath, "cfg", "", "default config path")
 }
 
-
-//Init config
+// Init config
 func Init() (err error) {
 	_, err = toml.DecodeFile(cfgPath, &Conf)
 	return
-}
\ No newline at end of file
+}
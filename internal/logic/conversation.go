@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	pb "github.com/RainJoe/mim/pb/logic"
+)
+
+const (
+	defaultConversationLimit = 20
+	maxConversationLimit     = 100
+)
+
+// conversationOf returns the id of the conversation of the user with the peer
+// or the group, the user must be a member of the group
+func (s *Service) conversationOf(uid string, peer string, group string) (string, error) {
+	switch {
+	case group != "":
+		if !s.Dao.IsUserInGroup(uid, group) {
+			return "", status.Errorf(codes.PermissionDenied, "%s is not a member of group %s", uid, group)
+		}
+		return model.GroupConversation(group), nil
+	case peer != "":
+		return model.C2CConversation(uid, peer), nil
+	}
+	return "", status.Error(codes.InvalidArgument, "peer or group required")
+}
+
+// ListConversations returns a page of the conversations of the user, the
+// cursor is the last time and id of the last conversation of the previous page
+func (s *Service) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	rsp := &pb.ListConversationsResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	var lastTime, id int64
+	if req.Cursor != "" {
+		if _, err := fmt.Sscanf(req.Cursor, "%d:%d", &lastTime, &id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad cursor %q", req.Cursor)
+		}
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultConversationLimit
+	}
+	if limit > maxConversationLimit {
+		limit = maxConversationLimit
+	}
+	convs, err := s.Dao.GetConversations(req.Uid, lastTime, id, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range convs {
+		rsp.Conversations = append(rsp.Conversations, &pb.Conversation{
+			Peer:         c.Peer,
+			Group:        c.GroupID,
+			LastMsgId:    c.LastMsgID,
+			LastFrom:     c.LastFrom,
+			LastContent:  c.LastContent,
+			LastMsgType:  int32(c.LastMsgType),
+			LastRecalled: c.LastRecalled,
+			LastSeq:      c.LastSeq,
+			LastTime:     c.LastTime,
+			Unread:       c.Unread,
+		})
+	}
+	if len(convs) == int(limit) {
+		last := convs[len(convs)-1]
+		rsp.NextCursor = fmt.Sprintf("%d:%d", last.LastTime, last.ID)
+	}
+	return rsp, nil
+}
+
+// ClearUnread resets the unread count of the conversation
+func (s *Service) ClearUnread(ctx context.Context, req *pb.ClearUnreadRequest) (*pb.ClearUnreadResponse, error) {
+	rsp := &pb.ClearUnreadResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	convID, err := s.conversationOf(req.Uid, req.Peer, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Dao.ClearUnread(req.Uid, convID); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// previewLength is the number of characters of the last message kept in the
+// conversation list
+const previewLength = 50
+
+func preview(content string) string {
+	r := []rune(content)
+	if len(r) > previewLength {
+		return string(r[:previewLength])
+	}
+	return content
+}
+
+const upsertConversation = ` ON CONFLICT (u_id, conv_id) DO UPDATE SET
+	last_msg_id = EXCLUDED.last_msg_id, last_from = EXCLUDED.last_from, last_content = EXCLUDED.last_content,
+	last_msg_type = EXCLUDED.last_msg_type, last_recalled = FALSE, last_seq = EXCLUDED.last_seq,
+	last_time = EXCLUDED.last_time, unread = im_conversation.unread + EXCLUDED.unread`
+
+// updateConversations sets the message as the last message of the
+// conversation for the sender and the recipients, and counts it as unread for
+// the recipients
+func updateConversations(tx *sqlx.Tx, msg *model.ImMessageSend) error {
+	content := preview(msg.MsgContent)
+	if msg.ConvID == model.GroupConversation(msg.MsgTo) {
+		sql := `INSERT INTO im_conversation (u_id, conv_id, group_id, last_msg_id, last_from, last_content, last_msg_type, last_seq, last_time, unread)
+			SELECT u_id, $1, $2, $3::BIGINT, $4::VARCHAR, $5, $6::SMALLINT, $7::BIGINT, $8::BIGINT, CASE WHEN u_id = $4 THEN 0 ELSE 1 END
+			FROM im_user_group WHERE group_id = $2` + upsertConversation
+		_, err := tx.Exec(sql, msg.ConvID, msg.MsgTo, msg.MsgID, msg.MsgFrom, content, msg.MsgType, msg.ConvSeq, msg.SendTime)
+		return err
+	}
+	sql := `INSERT INTO im_conversation (u_id, conv_id, peer, last_msg_id, last_from, last_content, last_msg_type, last_seq, last_time, unread)
+		VALUES ($1, $2, $3, $4, $1, $5, $6, $7, $8, 0)`
+	args := []interface{}{msg.MsgFrom, msg.ConvID, msg.MsgTo, msg.MsgID, content, msg.MsgType, msg.ConvSeq, msg.SendTime}
+	// a message to oneself only has one row to update
+	if msg.MsgFrom != msg.MsgTo {
+		sql += `, ($3, $2, $1, $4, $1, $5, $6, $7, $8, 1)`
+	}
+	_, err := tx.Exec(sql+upsertConversation, args...)
+	return err
+}
+
+// GetConversations returns a page of the conversations of the user, most
+// recent first. The page starts after the conversation with lastTime and id,
+// lastTime 0 starts from the most recent one.
+func (d *Dao) GetConversations(uid string, lastTime int64, id int64, limit int32) ([]*model.ImConversation, error) {
+	sql := `SELECT * FROM im_conversation WHERE u_id = $1`
+	args := []interface{}{uid}
+	if lastTime != 0 {
+		sql += ` AND (last_time, id) < ($2, $3)`
+		args = append(args, lastTime, id)
+	}
+	sql += ` ORDER BY last_time DESC, id DESC LIMIT ` + fmt.Sprintf("%d", limit)
+	convs := make([]*model.ImConversation, 0)
+	if err := d.DB.Select(&convs, sql, args...); err != nil {
+		return nil, err
+	}
+	return convs, nil
+}
+
+// ClearUnread resets the unread count of the conversation of the user
+func (d *Dao) ClearUnread(uid string, convID string) error {
+	sql := `UPDATE im_conversation SET unread = 0 WHERE u_id = $1 AND conv_id = $2`
+	if _, err := d.DB.Exec(sql, uid, convID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecrUnread lowers the unread count of the conversation of the user by the
+// number of messages read
+func (d *Dao) DecrUnread(uid string, convID string, n int) error {
+	sql := `UPDATE im_conversation SET unread = GREATEST(unread - $3, 0) WHERE u_id = $1 AND conv_id = $2`
+	if _, err := d.DB.Exec(sql, uid, convID, n); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRelatedUsers returns the targets uid has a conversation with or shares
+// a group with
+func (d *Dao) GetRelatedUsers(uid string, targets []string) ([]string, error) {
+	sql := `SELECT t FROM unnest($2::VARCHAR[]) AS t WHERE
+		EXISTS (SELECT 1 FROM im_conversation WHERE u_id = $1 AND peer = t)
+		OR EXISTS (SELECT 1 FROM im_user_group AS a JOIN im_user_group AS b ON a.group_id = b.group_id
+			WHERE a.u_id = $1 AND b.u_id = t)`
+	related := make([]string, 0)
+	if err := d.DB.Select(&related, sql, uid, pq.Array(targets)); err != nil {
+		return nil, err
+	}
+	return related, nil
+}
@@ -1,6 +1,8 @@
 package dao
 
 import (
+	dbsql "database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/RainJoe/mim/internal/logic/config"
 	"github.com/RainJoe/mim/internal/logic/model"
@@ -9,15 +11,17 @@ import (
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	"github.com/garyburd/redigo/redis"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
 )
 
-//Dao database access object
+// Dao database access object
 type Dao struct {
 	DB        *sqlx.DB
 	redisPool *redis.Pool
 }
 
-//New dao
+// New dao
 func New(c *config.Config) (d *Dao) {
 	return &Dao{
 		DB:        pg.NewPostgres(&c.Pg),
@@ -35,7 +39,7 @@ func (d *Dao) PingRedis() error {
 	return nil
 }
 
-//Close dao
+// Close dao
 func (d *Dao) Close() {
 	if d.DB != nil {
 		d.DB.Close()
@@ -45,10 +49,26 @@ func (d *Dao) Close() {
 	}
 }
 
-func (d *Dao) SetUserGateInfo(uid string, addr string) error {
+// AddUserSession adds or replaces the session of a device of the user
+func (d *Dao) AddUserSession(uid string, session *model.Session) error {
 	conn := d.redisPool.Get()
 	defer conn.Close()
-	_, err := conn.Do("SET", uid, addr)
+	b, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", uid, session.DeviceID, b)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteUserSession removes the session of a device of the user
+func (d *Dao) DeleteUserSession(uid string, deviceID string) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	_, err := conn.Do("HDEL", uid, deviceID)
 	if err != nil {
 		return err
 	}
@@ -65,6 +85,9 @@ func (d *Dao) DeleteUserFromRedis(uid string) error {
 	return nil
 }
 
+// IsUserOnline reports whether any device of the user has a session. Sessions
+// are removed together with the presence of their device, both agree on who
+// is online.
 func (d *Dao) IsUserOnline(uid string) bool {
 	conn := d.redisPool.Get()
 	defer conn.Close()
@@ -76,17 +99,32 @@ func (d *Dao) IsUserOnline(uid string) bool {
 }
 
 func (d *Dao) IsUserValid(uid string) bool {
-	return true
+	sql := `SELECT EXISTS(SELECT 1 FROM im_user WHERE u_id = $1)`
+	var exists bool
+	if err := d.DB.Get(&exists, sql, uid); err != nil {
+		log.Error(err)
+	}
+	return exists
 }
 
-func (d *Dao) GetGateOfUser(uid string) string {
+// GetUserSessions returns the sessions of all logged in devices of the user
+func (d *Dao) GetUserSessions(uid string) []*model.Session {
 	conn := d.redisPool.Get()
 	defer conn.Close()
-	addr, err := redis.String(conn.Do("GET", uid))
+	values, err := redis.StringMap(conn.Do("HGETALL", uid))
 	if err != nil {
 		log.Error(err)
 	}
-	return addr
+	sessions := make([]*model.Session, 0, len(values))
+	for _, v := range values {
+		var session model.Session
+		if err := json.Unmarshal([]byte(v), &session); err != nil {
+			log.Error(err)
+			continue
+		}
+		sessions = append(sessions, &session)
+	}
+	return sessions
 }
 
 func (d *Dao) GetUserFromGroup(group string) []string {
@@ -98,51 +136,188 @@ func (d *Dao) GetUserFromGroup(group string) []string {
 	return ids
 }
 
-func (d *Dao) SaveSendMessage(from string, to string, content string, seq int64, ts int64, msgType int) (int64, error) {
-	sql := `INSERT INTO im_message_send (msg_from, msg_to, msg_seq, msg_content, send_time, msg_type) VALUES ($1, $2, $3, $4, $5, $6) RETURNING msg_id;`
-	stmt, err := d.DB.Prepare(sql)
+// SaveSendMessage saves the message and fills in its id and the next sequence
+// number of its conversation. The sequence number is allocated in the same
+// transaction so conversations have no gaps, the conversation index of the
+// sender and recipients is updated in it too so it follows the sequence
+// order, and the receive records of the recipients are saved in it so a
+// saved message is never missing from their offline storage. A message with
+// a ClientMsgID already saved for the sender is not saved again, the ids of
+// the saved message are filled in and dup is set.
+func (d *Dao) SaveSendMessage(msg *model.ImMessageSend, recipients []string) (dup bool, err error) {
+	tx, err := d.DB.Beginx()
 	if err != nil {
-		return 0, err
+		return false, err
+	}
+	defer tx.Rollback()
+	sql := `INSERT INTO im_conversation_seq (conv_id, seq) VALUES ($1, 1)
+		ON CONFLICT (conv_id) DO UPDATE SET seq = im_conversation_seq.seq + 1 RETURNING seq`
+	if err := tx.Get(&msg.ConvSeq, sql, msg.ConvID); err != nil {
+		return false, err
+	}
+	payload := msg.Payload
+	if payload == "" {
+		payload = "{}"
+	}
+	sql = `INSERT INTO im_message_send (msg_from, msg_to, msg_seq, msg_content, send_time, msg_type, client_msg_id, conv_id, conv_seq, content_type, payload)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (msg_from, client_msg_id) WHERE client_msg_id <> '' DO NOTHING RETURNING msg_id;`
+	err = tx.Get(&msg.MsgID, sql, msg.MsgFrom, msg.MsgTo, msg.MsgSeq, msg.MsgContent, msg.SendTime, msg.MsgType, msg.ClientMsgID, msg.ConvID, msg.ConvSeq, msg.ContentType, payload)
+	if err == dbsql.ErrNoRows {
+		tx.Rollback()
+		sql = `SELECT msg_id, conv_seq FROM im_message_send WHERE msg_from = $1 AND client_msg_id = $2`
+		if err := d.DB.QueryRow(sql, msg.MsgFrom, msg.ClientMsgID).Scan(&msg.MsgID, &msg.ConvSeq); err != nil {
+			return false, err
+		}
+		return true, nil
 	}
-	defer stmt.Close()
-	var msgID int64
-	err = stmt.QueryRow(from, to, seq, content, ts, msgType).Scan(&msgID)
 	if err != nil {
-		return 0, err
+		return false, err
+	}
+	if len(recipients) > 0 {
+		sql = `INSERT INTO im_message_receive (msg_from, msg_to, msg_id) SELECT $1, unnest($2::VARCHAR[]), $3`
+		if _, err := tx.Exec(sql, msg.MsgFrom, pq.Array(recipients), msg.MsgID); err != nil {
+			return false, err
+		}
+	}
+	if err := updateConversations(tx, msg); err != nil {
+		return false, err
 	}
-	return msgID, nil
+	return false, tx.Commit()
 }
 
-func (d *Dao) SaveRecvMessage(from string, to string, msgID int64) error {
-	sql := `INSERT INTO im_message_receive (msg_from, msg_to, msg_id) VALUES ($1, $2, $3) RETURNING msg_id;`
-	stmt, err := d.DB.Prepare(sql)
+// GetSentMessageID returns the id and conversation sequence number of the
+// message the sender sent with clientMsgID from the redis cache
+func (d *Dao) GetSentMessageID(from string, clientMsgID string) (msgID int64, convSeq int64, ok bool) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	ids, err := redis.Int64s(conn.Do("HMGET", "sent:"+from+":"+clientMsgID, "msg_id", "conv_seq"))
 	if err != nil {
-		return err
+		if err != redis.ErrNil {
+			log.Error(err)
+		}
+		return 0, 0, false
 	}
-	defer stmt.Close()
-	_, err = stmt.Exec(from, to, msgID)
+	if ids[0] == 0 {
+		return 0, 0, false
+	}
+	return ids[0], ids[1], true
+}
+
+// SetSentMessageID caches the id and conversation sequence number of the
+// message the sender sent with clientMsgID for ttl seconds
+func (d *Dao) SetSentMessageID(from string, clientMsgID string, msgID int64, convSeq int64, ttl int) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	key := "sent:" + from + ":" + clientMsgID
+	conn.Send("MULTI")
+	conn.Send("HSET", key, "msg_id", msgID, "conv_seq", convSeq)
+	conn.Send("EXPIRE", key, ttl)
+	_, err := conn.Do("EXEC")
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *Dao) SetMsgRead(msgID int64) error {
-	sql := `UPDATE im_message_receive SET flag = 1 WHERE msg_id = $1`
+// CountSignal counts a signal of the user and returns the number of signals
+// the user sent in the current second
+func (d *Dao) CountSignal(uid string) (int64, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	key := "signal:" + uid
+	n, err := redis.Int64(conn.Do("INCR", key))
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		if _, err := conn.Do("PEXPIRE", key, 1000); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// SetMsgDelivered marks the message delivered to the user
+func (d *Dao) SetMsgDelivered(msgID int64, uid string) error {
+	sql := `UPDATE im_message_receive SET delivered_at = $3 WHERE msg_id = $1 AND msg_to = $2 AND delivered_at = 0`
 	stmt, err := d.DB.Prepare(sql)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	_, err = stmt.Exec(msgID)
+	_, err = stmt.Exec(msgID, uid, time.Now().UnixNano()/1e6)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *Dao) GetUnReadMessage(uid string, msgId int64, limit int32, out *[]*model.ImMessageSend) error {
-	sql := `SELECT t2.* FROM im_message_receive AS t1 LEFT JOIN im_message_send t2 ON t1.msg_id=t2.msg_id WHERE t1.flag = 0 AND t1.msg_to = $1`
+// MarkRead marks the messages the user received in the conversation up to
+// the sequence number read, messages not delivered yet are marked delivered
+// too. It returns the messages that were not read before.
+func (d *Dao) MarkRead(uid string, convID string, convSeq int64) ([]*model.ImMessageSend, error) {
+	sql := `UPDATE im_message_receive AS t1 SET read_at = $4,
+		delivered_at = CASE WHEN t1.delivered_at = 0 THEN $4 ELSE t1.delivered_at END
+		FROM im_message_send AS t2
+		WHERE t1.msg_id = t2.msg_id AND t1.msg_to = $1 AND t1.read_at = 0 AND t2.conv_id = $2 AND t2.conv_seq <= $3
+		RETURNING t2.*`
+	msgs := make([]*model.ImMessageSend, 0)
+	if err := d.DB.Select(&msgs, sql, uid, convID, convSeq, time.Now().UnixNano()/1e6); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// GetReadCounts returns how many recipients of each message of the group
+// have read it
+func (d *Dao) GetReadCounts(group string, msgIDs []int64) ([]*model.ReadCount, error) {
+	sql := `SELECT t1.msg_id, COUNT(*) FILTER (WHERE t1.read_at > 0) AS read_count, COUNT(*) AS total
+		FROM im_message_receive AS t1 JOIN im_message_send t2 ON t1.msg_id = t2.msg_id
+		WHERE t2.conv_id = $1 AND t1.msg_id = ANY($2) GROUP BY t1.msg_id ORDER BY t1.msg_id`
+	counts := make([]*model.ReadCount, 0)
+	if err := d.DB.Select(&counts, sql, model.GroupConversation(group), pq.Array(msgIDs)); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// GetMessage returns the sent message, nil if it does not exist
+func (d *Dao) GetMessage(msgID int64) (*model.ImMessageSend, error) {
+	sql := `SELECT * FROM im_message_send WHERE msg_id = $1`
+	var msg model.ImMessageSend
+	if err := d.DB.Get(&msg, sql, msgID); err != nil {
+		if err == dbsql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// RecallMessage marks the sent message as recalled
+func (d *Dao) RecallMessage(msgID int64) error {
+	tx, err := d.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	sql := `UPDATE im_message_send SET recalled = TRUE WHERE msg_id = $1`
+	if _, err := tx.Exec(sql, msgID); err != nil {
+		return err
+	}
+	// the recalled content must not stay in the conversation list either
+	sql = `UPDATE im_conversation SET last_content = '', last_recalled = TRUE
+		WHERE conv_id = (SELECT conv_id FROM im_message_send WHERE msg_id = $1) AND last_msg_id = $1`
+	if _, err := tx.Exec(sql, msgID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetUndeliveredMessage returns the messages not delivered to the user yet
+func (d *Dao) GetUndeliveredMessage(uid string, msgId int64, limit int32, out *[]*model.ImMessageSend) error {
+	sql := `SELECT t2.* FROM im_message_receive AS t1 LEFT JOIN im_message_send t2 ON t1.msg_id=t2.msg_id WHERE t1.delivered_at = 0 AND t1.msg_to = $1`
 	if msgId != 0 {
 		sql += ` AND t1.msg_id > ` + fmt.Sprintf("%d", msgId)
 	}
@@ -154,4 +329,212 @@ func (d *Dao) GetUnReadMessage(uid string, msgId int64, limit int32, out *[]*mod
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// GetMessagesBySeq returns the messages of the conversation with a sequence
+// number in [fromSeq, toSeq], toSeq 0 means no upper bound
+func (d *Dao) GetMessagesBySeq(convID string, fromSeq int64, toSeq int64, limit int32, out *[]*model.ImMessageSend) error {
+	sql := `SELECT * FROM im_message_send WHERE conv_id = $1 AND conv_seq >= $2`
+	args := []interface{}{convID, fromSeq}
+	if toSeq != 0 {
+		sql += ` AND conv_seq <= $3`
+		args = append(args, toSeq)
+	}
+	sql += ` ORDER BY conv_seq`
+	if limit != 0 {
+		sql += ` LIMIT ` + fmt.Sprintf("%d", limit)
+	}
+	if err := d.DB.Select(out, sql, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetHistory returns limit messages of the conversation before the sequence
+// number in descending order, or after it in ascending order when forward is
+// set. A cursor of 0 starts from the latest or the first message.
+func (d *Dao) GetHistory(convID string, cursor int64, forward bool, limit int32) ([]*model.ImMessageSend, error) {
+	sql := `SELECT * FROM im_message_send WHERE conv_id = $1`
+	args := []interface{}{convID}
+	switch {
+	case forward:
+		sql += ` AND conv_seq > $2 ORDER BY conv_seq`
+		args = append(args, cursor)
+	case cursor != 0:
+		sql += ` AND conv_seq < $2 ORDER BY conv_seq DESC`
+		args = append(args, cursor)
+	default:
+		sql += ` ORDER BY conv_seq DESC`
+	}
+	sql += ` LIMIT ` + fmt.Sprintf("%d", limit)
+	msgs := make([]*model.ImMessageSend, 0)
+	if err := d.DB.Select(&msgs, sql, args...); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// IsUserInGroup reports whether the user is a member of the group
+func (d *Dao) IsUserInGroup(uid string, group string) bool {
+	sql := `SELECT EXISTS(SELECT 1 FROM im_user_group WHERE u_id = $1 AND group_id = $2)`
+	var exists bool
+	if err := d.DB.Get(&exists, sql, uid, group); err != nil {
+		log.Error(err)
+	}
+	return exists
+}
+
+// GetGroup returns the group, nil if it does not exist
+func (d *Dao) GetGroup(group string) (*model.ImGroup, error) {
+	sql := `SELECT * FROM im_group WHERE group_id = $1`
+	var g model.ImGroup
+	if err := d.DB.Get(&g, sql, group); err != nil {
+		if err == dbsql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &g, nil
+}
+
+// CreateGroup creates the group owned by the owner with the other members,
+// users join an open group without an invitation
+func (d *Dao) CreateGroup(group string, name string, open bool, owner string, members []string) error {
+	tx, err := d.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	sql := `INSERT INTO im_group (group_id, group_name, open) VALUES ($1, $2, $3)`
+	if _, err := tx.Exec(sql, group, name, open); err != nil {
+		return err
+	}
+	sql = `INSERT INTO im_user_group (u_id, group_id, role) VALUES ($1, $2, $3)`
+	if _, err := tx.Exec(sql, owner, group, model.RoleOwner); err != nil {
+		return err
+	}
+	sql = `INSERT INTO im_user_group (u_id, group_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	for _, uid := range members {
+		if _, err := tx.Exec(sql, uid, group); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *Dao) RenameGroup(group string, name string) error {
+	sql := `UPDATE im_group SET group_name = $1 WHERE group_id = $2`
+	if _, err := d.DB.Exec(sql, name, group); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteGroup deletes the group and its members
+func (d *Dao) DeleteGroup(group string) error {
+	tx, err := d.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`DELETE FROM im_user_group WHERE group_id = $1`, group); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM im_group WHERE group_id = $1`, group); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// AddGroupMembers adds the users to the group, users already in the group are
+// skipped
+func (d *Dao) AddGroupMembers(group string, uids []string) error {
+	tx, err := d.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	sql := `INSERT INTO im_user_group (u_id, group_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	for _, uid := range uids {
+		if _, err := tx.Exec(sql, uid, group); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *Dao) RemoveGroupMembers(group string, uids []string) error {
+	sql := `DELETE FROM im_user_group WHERE group_id = $1 AND u_id = ANY($2)`
+	if _, err := d.DB.Exec(sql, group, pq.Array(uids)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetGroupsOfUser returns the groups the user is a member of with the role of
+// the user in them
+func (d *Dao) GetGroupsOfUser(uid string) ([]*model.UserGroup, error) {
+	sql := `SELECT t2.*, t1.role FROM im_user_group AS t1 JOIN im_group t2 ON t1.group_id = t2.group_id WHERE t1.u_id = $1 ORDER BY t2.id`
+	groups := make([]*model.UserGroup, 0)
+	if err := d.DB.Select(&groups, sql, uid); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// GetMemberRole returns the role of the user in the group, ok is false if the
+// user is not a member
+func (d *Dao) GetMemberRole(uid string, group string) (role int, ok bool, err error) {
+	sql := `SELECT role FROM im_user_group WHERE u_id = $1 AND group_id = $2`
+	if err := d.DB.Get(&role, sql, uid, group); err != nil {
+		if err == dbsql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return role, true, nil
+}
+
+// GetGroupMembers returns the members of the group with their roles
+func (d *Dao) GetGroupMembers(group string) ([]*model.ImUserGroup, error) {
+	sql := `SELECT * FROM im_user_group WHERE group_id = $1 ORDER BY role DESC, id`
+	members := make([]*model.ImUserGroup, 0)
+	if err := d.DB.Select(&members, sql, group); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (d *Dao) SetMemberRole(group string, uid string, role int) error {
+	sql := `UPDATE im_user_group SET role = $1 WHERE group_id = $2 AND u_id = $3`
+	if _, err := d.DB.Exec(sql, role, group, uid); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TransferGroupOwner makes the member the owner of the group, the previous
+// owner becomes an admin
+func (d *Dao) TransferGroupOwner(group string, owner string, member string) error {
+	tx, err := d.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	sql := `UPDATE im_user_group SET role = $1 WHERE group_id = $2 AND u_id = $3`
+	if _, err := tx.Exec(sql, model.RoleAdmin, group, owner); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(sql, model.RoleOwner, group, member); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *Dao) MuteGroup(group string, muted bool) error {
+	sql := `UPDATE im_group SET muted = $1 WHERE group_id = $2`
+	if _, err := d.DB.Exec(sql, muted, group); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	"github.com/garyburd/redigo/redis"
+)
+
+// The recipients a message is pending for are fields of the hash
+// delivery:<msg_id>, the hash expires once no logic server redelivers the
+// message anymore.
+func deliveryKey(msgID int64) string {
+	return "delivery:" + strconv.FormatInt(msgID, 10)
+}
+
+// AddDelivery marks the message pending for uid until it is acked or ttl
+// passed
+func (d *Dao) AddDelivery(msgID int64, uid string, ttl time.Duration) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	conn.Send("HSET", deliveryKey(msgID), uid, 1)
+	conn.Send("PEXPIRE", deliveryKey(msgID), ttl.Nanoseconds()/1e6)
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// AckDelivery removes the mark of the message for uid and reports whether
+// it was pending
+func (d *Dao) AckDelivery(msgID int64, uid string) (bool, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	return redis.Bool(conn.Do("HDEL", deliveryKey(msgID), uid))
+}
+
+// CancelDeliveries removes the marks of the message for all recipients
+func (d *Dao) CancelDeliveries(msgID int64) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	_, err := conn.Do("DEL", deliveryKey(msgID))
+	return err
+}
+
+// PendingDeliveries reports for each delivery whether it is still pending
+func (d *Dao) PendingDeliveries(deliveries []model.Delivery) ([]bool, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	for _, dlv := range deliveries {
+		conn.Send("HEXISTS", deliveryKey(dlv.MsgID), dlv.UID)
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	pending := make([]bool, len(deliveries))
+	for i := range deliveries {
+		ok, err := redis.Bool(conn.Receive())
+		if err != nil {
+			return nil, err
+		}
+		pending[i] = ok
+	}
+	return pending, nil
+}
@@ -0,0 +1,256 @@
+package dao
+
+import (
+	"strings"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	"github.com/garyburd/redigo/redis"
+)
+
+// The devices of a user are fields of the hash presence:<uid> with the value
+// "<state>|<gate>", their deadlines are the scores of the members
+// "<uid>\n<device>" of the sorted set presence:deadlines. The state of a user
+// is online when any device is online, away when all devices are away.
+const (
+	presenceDeadlines = "presence:deadlines"
+	// presence ops of the presence script
+	presenceSet       = "set"
+	presenceHeartbeat = "heartbeat"
+	presenceRemove    = "remove"
+	presenceExpire    = "expire"
+)
+
+// presenceScript updates a device and returns the state of the user before
+// and after. A heartbeat keeps the state of the device and is ignored when
+// the device moved to another gate, so is a remove with a gate. An expire
+// only removes the device if its deadline passed. Removing a device removes
+// its session too unless the device logged in again on another gate.
+var presenceScript = redis.NewScript(4, `
+local function aggregate(key)
+	local state = 0
+	for _, v in ipairs(redis.call('HVALS', key)) do
+		local s = tonumber(string.match(v, '^(%d+)'))
+		if s == 1 then return 1 end
+		if s == 2 then state = 2 end
+	end
+	return state
+end
+local op, device, state, gate, deadline, member, now = ARGV[1], ARGV[2], ARGV[3], ARGV[4], ARGV[5], ARGV[6], ARGV[7]
+local old = aggregate(KEYS[1])
+local cur = redis.call('HGET', KEYS[1], device)
+local curGate = cur and string.match(cur, '|(.*)$')
+local function removeSession(g)
+	local session = redis.call('HGET', KEYS[4], device)
+	if session and g and g ~= '' and cjson.decode(session).gate == g then
+		redis.call('HDEL', KEYS[4], device)
+	end
+end
+if op == 'expire' then
+	local score = redis.call('ZSCORE', KEYS[2], member)
+	if not score or tonumber(score) > tonumber(now) then return {old, old} end
+	redis.call('HDEL', KEYS[1], device)
+	redis.call('ZREM', KEYS[2], member)
+	removeSession(curGate)
+elseif op == 'remove' then
+	if cur and gate ~= '' and curGate ~= gate then return {old, old} end
+	redis.call('HDEL', KEYS[1], device)
+	redis.call('ZREM', KEYS[2], member)
+	removeSession(curGate or gate)
+else
+	if op == 'heartbeat' and cur then
+		if curGate ~= gate then return {old, old} end
+		state = string.match(cur, '^(%d+)')
+	end
+	redis.call('HSET', KEYS[1], device, state .. '|' .. gate)
+	redis.call('ZADD', KEYS[2], deadline, member)
+end
+local new = aggregate(KEYS[1])
+if old ~= 0 or new ~= 0 then
+	redis.call('SET', KEYS[3], now)
+end
+return {old, new}
+`)
+
+func presenceKey(uid string) string {
+	return "presence:" + uid
+}
+
+func lastSeenKey(uid string) string {
+	return "lastseen:" + uid
+}
+
+func presenceMember(uid string, deviceID string) string {
+	return uid + "\n" + deviceID
+}
+
+func presenceArgs(op string, uid string, deviceID string, state int32, gate string, ttl time.Duration, now time.Time) []interface{} {
+	return []interface{}{
+		presenceKey(uid), presenceDeadlines, lastSeenKey(uid), uid,
+		op, deviceID, state, gate, now.Add(ttl).UnixNano() / 1e6, presenceMember(uid, deviceID), now.UnixNano() / 1e6,
+	}
+}
+
+func (d *Dao) updatePresence(op string, uid string, deviceID string, state int32, gate string, ttl time.Duration) (before int32, after int32, err error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	states, err := redis.Ints(presenceScript.Do(conn, presenceArgs(op, uid, deviceID, state, gate, ttl, time.Now())...))
+	if err != nil {
+		return 0, 0, err
+	}
+	return int32(states[0]), int32(states[1]), nil
+}
+
+// SetPresence sets the state of the device connected to gate, it expires
+// after ttl unless refreshed
+func (d *Dao) SetPresence(uid string, deviceID string, state int32, gate string, ttl time.Duration) (before int32, after int32, err error) {
+	return d.updatePresence(presenceSet, uid, deviceID, state, gate, ttl)
+}
+
+// RefreshPresence extends the deadline of the device connected to gate by
+// ttl, a device that already expired comes back online
+func (d *Dao) RefreshPresence(uid string, deviceID string, gate string, ttl time.Duration) (before int32, after int32, err error) {
+	return d.updatePresence(presenceHeartbeat, uid, deviceID, model.PresenceOnline, gate, ttl)
+}
+
+// RefreshPresences refreshes the devices connected to gate in one round trip
+// and returns the states of their users before and after each refresh
+func (d *Dao) RefreshPresences(devices [][2]string, gate string, ttl time.Duration) ([][2]int32, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	if err := presenceScript.Load(conn); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, dev := range devices {
+		args := presenceArgs(presenceHeartbeat, dev[0], dev[1], model.PresenceOnline, gate, ttl, now)
+		if err := presenceScript.SendHash(conn, args...); err != nil {
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	states := make([][2]int32, len(devices))
+	for i := range devices {
+		s, err := redis.Ints(conn.Receive())
+		if err != nil {
+			return nil, err
+		}
+		states[i] = [2]int32{int32(s[0]), int32(s[1])}
+	}
+	return states, nil
+}
+
+// RemovePresence removes the device, with a gate only if the device is
+// still connected to it
+func (d *Dao) RemovePresence(uid string, deviceID string, gate string) (before int32, after int32, err error) {
+	return d.updatePresence(presenceRemove, uid, deviceID, model.PresenceOffline, gate, 0)
+}
+
+// ExpirePresence removes the device if its deadline passed
+func (d *Dao) ExpirePresence(uid string, deviceID string) (before int32, after int32, err error) {
+	return d.updatePresence(presenceExpire, uid, deviceID, model.PresenceOffline, "", 0)
+}
+
+// GetExpiredPresences returns at most limit devices whose deadline passed as
+// pairs of user and device id
+func (d *Dao) GetExpiredPresences(limit int) ([][2]string, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	members, err := redis.Strings(conn.Do("ZRANGEBYSCORE", presenceDeadlines, "-inf", time.Now().UnixNano()/1e6, "LIMIT", 0, limit))
+	if err != nil {
+		return nil, err
+	}
+	devices := make([][2]string, 0, len(members))
+	for _, m := range members {
+		if i := strings.IndexByte(m, '\n'); i >= 0 {
+			devices = append(devices, [2]string{m[:i], m[i+1:]})
+		}
+	}
+	return devices, nil
+}
+
+// GetPresences returns the presence of the users
+func (d *Dao) GetPresences(uids []string) ([]*model.Presence, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	for _, uid := range uids {
+		conn.Send("HVALS", presenceKey(uid))
+		conn.Send("GET", lastSeenKey(uid))
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	presences := make([]*model.Presence, 0, len(uids))
+	for _, uid := range uids {
+		values, err := redis.Strings(conn.Receive())
+		if err != nil {
+			return nil, err
+		}
+		lastSeen, err := redis.Int64(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			return nil, err
+		}
+		p := &model.Presence{UID: uid, State: model.PresenceOffline, LastSeen: lastSeen}
+		for _, v := range values {
+			if strings.HasPrefix(v, "1|") {
+				p.State = model.PresenceOnline
+				break
+			}
+			if strings.HasPrefix(v, "2|") {
+				p.State = model.PresenceAway
+			}
+		}
+		presences = append(presences, p)
+	}
+	return presences, nil
+}
+
+func presenceSubscribersKey(uid string) string {
+	return "presence:subscribers:" + uid
+}
+
+func presenceSubscriptionsKey(uid string) string {
+	return "presence:subscriptions:" + uid
+}
+
+// GetPresenceSubscriptionCount returns the number of users uid subscribed to
+func (d *Dao) GetPresenceSubscriptionCount(uid string) (int, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	return redis.Int(conn.Do("SCARD", presenceSubscriptionsKey(uid)))
+}
+
+// SubscribePresence subscribes uid to the presence of the targets
+func (d *Dao) SubscribePresence(uid string, targets []string) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	for _, target := range targets {
+		conn.Send("SADD", presenceSubscribersKey(target), uid)
+		conn.Send("SADD", presenceSubscriptionsKey(uid), target)
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// UnsubscribePresence removes the subscriptions of uid to the targets
+func (d *Dao) UnsubscribePresence(uid string, targets []string) error {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	for _, target := range targets {
+		conn.Send("SREM", presenceSubscribersKey(target), uid)
+		conn.Send("SREM", presenceSubscriptionsKey(uid), target)
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// GetPresenceSubscribers returns the users subscribed to the presence of uid
+func (d *Dao) GetPresenceSubscribers(uid string) ([]string, error) {
+	conn := d.redisPool.Get()
+	defer conn.Close()
+	return redis.Strings(conn.Do("SMEMBERS", presenceSubscribersKey(uid)))
+}
@@ -0,0 +1,25 @@
+package logic
+
+import (
+	"github.com/RainJoe/mim/internal/logic/model"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// sentMessageID looks up the id of a message the sender already sent in the
+// short-lived cache, the unique index on im_message_send catches what the
+// cache misses.
+func (s *Service) sentMessageID(from string, clientMsgID string) (msgID int64, convSeq int64, ok bool) {
+	if clientMsgID == "" {
+		return 0, 0, false
+	}
+	return s.Dao.GetSentMessageID(from, clientMsgID)
+}
+
+func (s *Service) cacheSentMessageID(msg *model.ImMessageSend) {
+	if msg.ClientMsgID == "" || s.Conf.LogicServer.DedupTTL <= 0 {
+		return
+	}
+	if err := s.Dao.SetSentMessageID(msg.MsgFrom, msg.ClientMsgID, msg.MsgID, msg.ConvSeq, s.Conf.LogicServer.DedupTTL); err != nil {
+		log.Error(err)
+	}
+}
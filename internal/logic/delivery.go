@@ -0,0 +1,250 @@
+package logic
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// errUserOffline is returned by a push when the recipient has no session,
+// the tracker then leaves the message for the offline pull right away.
+var errUserOffline = errors.New("user offline")
+
+type delivery struct {
+	push     func() error
+	retries  int
+	deadline time.Time
+}
+
+// DeliveryStore keeps which deliveries are pending. The ack of a message is
+// sent for its recipient and may reach another logic server than the one
+// that pushed it, the store is shared by all of them.
+type DeliveryStore interface {
+	AddDelivery(msgID int64, uid string, ttl time.Duration) error
+	AckDelivery(msgID int64, uid string) (bool, error)
+	CancelDeliveries(msgID int64) error
+	PendingDeliveries(deliveries []model.Delivery) ([]bool, error)
+}
+
+// DeliveryTracker tracks pushed messages until the recipient acks them.
+// Unacked messages are pushed again after the ack timeout, after MaxRetries
+// redeliveries the tracker gives up and the message stays in the offline
+// storage until the recipient pulls it. The tracker that pushed a message
+// redelivers it, acks and cancels are recorded in the store and seen by it
+// before each redelivery.
+type DeliveryTracker struct {
+	store      DeliveryStore
+	timeout    time.Duration
+	maxRetries int
+
+	mu      sync.Mutex
+	pending map[model.Delivery]*delivery
+	done    chan struct{}
+
+	tracked     uint64
+	acked       uint64
+	redelivered uint64
+	gaveUp      uint64
+}
+
+// DeliveryStats is a snapshot of the delivery counters
+type DeliveryStats struct {
+	Pending     int
+	Tracked     uint64
+	Acked       uint64
+	Redelivered uint64
+	GaveUp      uint64
+}
+
+// NewDeliveryTracker creates a tracker redelivering messages not acked within
+// timeout at most maxRetries times.
+func NewDeliveryTracker(store DeliveryStore, timeout time.Duration, maxRetries int) *DeliveryTracker {
+	t := &DeliveryTracker{
+		store:      store,
+		timeout:    timeout,
+		maxRetries: maxRetries,
+		pending:    make(map[model.Delivery]*delivery),
+		done:       make(chan struct{}),
+	}
+	go t.loop()
+	return t
+}
+
+// Track starts tracking the message about to be pushed to uid, push is
+// called to push the message again. Tracking starts before the first push so
+// that an ack arriving right after it finds the message.
+func (t *DeliveryTracker) Track(msgID int64, uid string, push func() error) {
+	// the mark outlives the last redelivery
+	ttl := t.timeout * time.Duration(t.maxRetries+2)
+	if err := t.store.AddDelivery(msgID, uid, ttl); err != nil {
+		log.Error(err)
+	}
+	atomic.AddUint64(&t.tracked, 1)
+	t.mu.Lock()
+	t.pending[model.Delivery{MsgID: msgID, UID: uid}] = &delivery{
+		push:     push,
+		deadline: time.Now().Add(t.timeout),
+	}
+	t.mu.Unlock()
+}
+
+// forget stops tracking the message for uid on this server and reports
+// whether it was tracked here.
+func (t *DeliveryTracker) forget(key model.Delivery) bool {
+	t.mu.Lock()
+	_, ok := t.pending[key]
+	delete(t.pending, key)
+	t.mu.Unlock()
+	return ok
+}
+
+// Untrack stops tracking the message that could not be pushed to uid
+// because uid is offline, it is left for the offline pull.
+func (t *DeliveryTracker) Untrack(msgID int64, uid string) {
+	if _, err := t.store.AckDelivery(msgID, uid); err != nil {
+		log.Error(err)
+	}
+	if t.forget(model.Delivery{MsgID: msgID, UID: uid}) {
+		atomic.AddUint64(&t.tracked, ^uint64(0))
+	}
+}
+
+// Ack stops tracking the message delivered to uid, whichever server tracks
+// it.
+func (t *DeliveryTracker) Ack(msgID int64, uid string) {
+	t.forget(model.Delivery{MsgID: msgID, UID: uid})
+	ok, err := t.store.AckDelivery(msgID, uid)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if ok {
+		atomic.AddUint64(&t.acked, 1)
+	}
+}
+
+// Cancel stops tracking the message for all recipients, a recalled message
+// must not be pushed again.
+func (t *DeliveryTracker) Cancel(msgID int64) {
+	if err := t.store.CancelDeliveries(msgID); err != nil {
+		log.Error(err)
+	}
+	t.mu.Lock()
+	for key := range t.pending {
+		if key.MsgID == msgID {
+			delete(t.pending, key)
+		}
+	}
+	t.mu.Unlock()
+}
+
+func (t *DeliveryTracker) loop() {
+	interval := t.timeout / 4
+	if interval < 100*time.Millisecond {
+		interval = 100 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.redeliver()
+		case <-t.done:
+			return
+		}
+	}
+}
+
+func (t *DeliveryTracker) redeliver() {
+	now := time.Now()
+	due := make([]model.Delivery, 0)
+	t.mu.Lock()
+	for key, d := range t.pending {
+		if !now.Before(d.deadline) {
+			due = append(due, key)
+		}
+	}
+	t.mu.Unlock()
+	if len(due) == 0 {
+		return
+	}
+	pending, err := t.store.PendingDeliveries(due)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	pushes := make(map[model.Delivery]func() error)
+	gaveUp := make([]model.Delivery, 0)
+	t.mu.Lock()
+	for i, key := range due {
+		d, ok := t.pending[key]
+		if !ok {
+			continue
+		}
+		if !pending[i] {
+			// acked through another server or cancelled
+			delete(t.pending, key)
+			continue
+		}
+		if d.retries >= t.maxRetries {
+			delete(t.pending, key)
+			gaveUp = append(gaveUp, key)
+			continue
+		}
+		d.retries++
+		d.deadline = now.Add(t.timeout)
+		atomic.AddUint64(&t.redelivered, 1)
+		pushes[key] = d.push
+	}
+	t.mu.Unlock()
+	for _, key := range gaveUp {
+		atomic.AddUint64(&t.gaveUp, 1)
+		log.Infof("message %d not acked by %s, left for offline pull", key.MsgID, key.UID)
+		if _, err := t.store.AckDelivery(key.MsgID, key.UID); err != nil {
+			log.Error(err)
+		}
+	}
+	for key, push := range pushes {
+		go func(key model.Delivery, push func() error) {
+			err := push()
+			if err == errUserOffline {
+				t.giveUp(key)
+			} else if err != nil {
+				log.Error(err)
+			}
+		}(key, push)
+	}
+}
+
+func (t *DeliveryTracker) giveUp(key model.Delivery) {
+	if !t.forget(key) {
+		return
+	}
+	atomic.AddUint64(&t.gaveUp, 1)
+	if _, err := t.store.AckDelivery(key.MsgID, key.UID); err != nil {
+		log.Error(err)
+	}
+}
+
+// Stats returns a snapshot of the delivery counters
+func (t *DeliveryTracker) Stats() DeliveryStats {
+	t.mu.Lock()
+	pending := len(t.pending)
+	t.mu.Unlock()
+	return DeliveryStats{
+		Pending:     pending,
+		Tracked:     atomic.LoadUint64(&t.tracked),
+		Acked:       atomic.LoadUint64(&t.acked),
+		Redelivered: atomic.LoadUint64(&t.redelivered),
+		GaveUp:      atomic.LoadUint64(&t.gaveUp),
+	}
+}
+
+// Close stops redelivering
+func (t *DeliveryTracker) Close() {
+	close(t.done)
+}
@@ -0,0 +1,204 @@
+package logic
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+)
+
+const testAckTimeout = 50 * time.Millisecond
+
+// memDeliveryStore is a DeliveryStore in memory, trackers sharing one behave
+// like logic servers sharing redis.
+type memDeliveryStore struct {
+	mu      sync.Mutex
+	pending map[model.Delivery]bool
+}
+
+func newMemDeliveryStore() *memDeliveryStore {
+	return &memDeliveryStore{pending: make(map[model.Delivery]bool)}
+}
+
+func (m *memDeliveryStore) AddDelivery(msgID int64, uid string, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending[model.Delivery{MsgID: msgID, UID: uid}] = true
+	return nil
+}
+
+func (m *memDeliveryStore) AckDelivery(msgID int64, uid string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := model.Delivery{MsgID: msgID, UID: uid}
+	ok := m.pending[key]
+	delete(m.pending, key)
+	return ok, nil
+}
+
+func (m *memDeliveryStore) CancelDeliveries(msgID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.pending {
+		if key.MsgID == msgID {
+			delete(m.pending, key)
+		}
+	}
+	return nil
+}
+
+func (m *memDeliveryStore) PendingDeliveries(deliveries []model.Delivery) ([]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending := make([]bool, len(deliveries))
+	for i, key := range deliveries {
+		pending[i] = m.pending[key]
+	}
+	return pending, nil
+}
+
+// waitFor polls cond until it holds or a second passed.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDeliveryTrackerAck(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), testAckTimeout, 3)
+	defer tr.Close()
+	var pushes int32
+	tr.Track(1, "alice", func() error {
+		atomic.AddInt32(&pushes, 1)
+		return nil
+	})
+	// the ack of a fast client may arrive right after the first push
+	tr.Ack(1, "alice")
+	time.Sleep(4 * testAckTimeout)
+	if n := atomic.LoadInt32(&pushes); n != 0 {
+		t.Errorf("acked message pushed again %d times", n)
+	}
+	stats := tr.Stats()
+	if stats.Pending != 0 || stats.Tracked != 1 || stats.Acked != 1 || stats.Redelivered != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDeliveryTrackerRedeliver(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), testAckTimeout, 2)
+	defer tr.Close()
+	var pushes int32
+	tr.Track(1, "alice", func() error {
+		atomic.AddInt32(&pushes, 1)
+		return nil
+	})
+	waitFor(t, func() bool { return tr.Stats().GaveUp == 1 })
+	if n := atomic.LoadInt32(&pushes); n != 2 {
+		t.Errorf("message pushed again %d times, want 2", n)
+	}
+	stats := tr.Stats()
+	if stats.Pending != 0 || stats.Redelivered != 2 || stats.Acked != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDeliveryTrackerAckAfterRedelivery(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), testAckTimeout, 5)
+	defer tr.Close()
+	var pushes int32
+	tr.Track(1, "alice", func() error {
+		atomic.AddInt32(&pushes, 1)
+		return nil
+	})
+	waitFor(t, func() bool { return atomic.LoadInt32(&pushes) == 1 })
+	tr.Ack(1, "alice")
+	time.Sleep(4 * testAckTimeout)
+	if n := atomic.LoadInt32(&pushes); n != 1 {
+		t.Errorf("message pushed again %d times after the ack, want 1", n)
+	}
+	if stats := tr.Stats(); stats.Pending != 0 || stats.Acked != 1 || stats.GaveUp != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDeliveryTrackerOffline(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), testAckTimeout, 5)
+	defer tr.Close()
+	var pushes int32
+	tr.Track(1, "alice", func() error {
+		atomic.AddInt32(&pushes, 1)
+		return errUserOffline
+	})
+	waitFor(t, func() bool { return tr.Stats().GaveUp == 1 })
+	if n := atomic.LoadInt32(&pushes); n != 1 {
+		t.Errorf("message pushed again %d times to an offline user, want 1", n)
+	}
+}
+
+func TestDeliveryTrackerUntrack(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), testAckTimeout, 3)
+	defer tr.Close()
+	var pushes int32
+	tr.Track(1, "alice", func() error {
+		atomic.AddInt32(&pushes, 1)
+		return nil
+	})
+	tr.Untrack(1, "alice")
+	time.Sleep(4 * testAckTimeout)
+	if n := atomic.LoadInt32(&pushes); n != 0 {
+		t.Errorf("untracked message pushed again %d times", n)
+	}
+	if stats := tr.Stats(); stats.Pending != 0 || stats.Tracked != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDeliveryTrackerCancel(t *testing.T) {
+	tr := NewDeliveryTracker(newMemDeliveryStore(), time.Hour, 3)
+	defer tr.Close()
+	push := func() error { return nil }
+	tr.Track(1, "alice", push)
+	tr.Track(1, "bob", push)
+	tr.Track(2, "alice", push)
+	tr.Cancel(1)
+	if pending := tr.Stats().Pending; pending != 1 {
+		t.Errorf("%d messages pending after cancel, want 1", pending)
+	}
+	tr.Ack(2, "bob")
+	if pending := tr.Stats().Pending; pending != 1 {
+		t.Errorf("ack of another recipient removed the message")
+	}
+}
+
+// The gate balances a send by its sender and the ack by the recipient, with
+// several logic servers they reach different trackers.
+func TestDeliveryTrackerAckOnOtherServer(t *testing.T) {
+	store := newMemDeliveryStore()
+	sender := NewDeliveryTracker(store, testAckTimeout, 5)
+	defer sender.Close()
+	recipient := NewDeliveryTracker(store, testAckTimeout, 5)
+	defer recipient.Close()
+	var pushes int32
+	push := func() error {
+		atomic.AddInt32(&pushes, 1)
+		return nil
+	}
+	sender.Track(1, "bob", push)
+	sender.Track(2, "bob", push)
+	recipient.Ack(1, "bob")
+	recipient.Cancel(2)
+	waitFor(t, func() bool { return sender.Stats().Pending == 0 })
+	if n := atomic.LoadInt32(&pushes); n != 0 {
+		t.Errorf("message acked on the other server pushed again %d times", n)
+	}
+	if stats := recipient.Stats(); stats.Acked != 1 {
+		t.Errorf("unexpected stats of the acking server %+v", stats)
+	}
+}
@@ -0,0 +1,442 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	pb "github.com/RainJoe/mim/pb/logic"
+)
+
+// maxGroupName is the length in characters of the group_name column
+const maxGroupName = 50
+
+func validGroupName(name string) bool {
+	return strings.TrimSpace(name) != "" && utf8.RuneCountInString(name) <= maxGroupName
+}
+
+func newGroupResponse(group string, seq int64) *pb.GroupResponse {
+	return &pb.GroupResponse{
+		Status: int32(pb.GroupResponse_SUCCESS),
+		Msg:    "Success",
+		Group:  group,
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    seq,
+	}
+}
+
+func failGroupResponse(rsp *pb.GroupResponse, status pb.GroupResponse_Status) *pb.GroupResponse {
+	rsp.Status = int32(status)
+	rsp.Msg = status.String()
+	return rsp
+}
+
+func newGroupID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// requireRole checks the group exists and the user is a member of it with at
+// least the role, it returns the role of the user
+func (s *Service) requireRole(group string, uid string, role int) (int, pb.GroupResponse_Status, error) {
+	g, err := s.Dao.GetGroup(group)
+	if err != nil {
+		return 0, 0, err
+	}
+	if g == nil {
+		return 0, pb.GroupResponse_GROUP_NOT_FOUND, nil
+	}
+	r, ok, err := s.Dao.GetMemberRole(uid, group)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ok {
+		return 0, pb.GroupResponse_NOT_MEMBER, nil
+	}
+	if r < role {
+		return r, pb.GroupResponse_PERMISSION_DENIED, nil
+	}
+	return r, pb.GroupResponse_SUCCESS, nil
+}
+
+// notifyGroup sends the notice as a group system message to the recipients
+func (s *Service) notifyGroup(group string, notice *model.GroupNotice, recipients []string) error {
+	content, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+	msg := &model.ImMessageSend{
+		MsgFrom:    notice.Operator,
+		MsgTo:      group,
+		MsgContent: string(content),
+		SendTime:   time.Now().UnixNano() / 1e6,
+		MsgType:    GroupNoticeMessage,
+		ConvID:     model.GroupConversation(group),
+	}
+	if _, err := s.Dao.SaveSendMessage(msg, recipients); err != nil {
+		return err
+	}
+	s.sendGroupMessage(msg, recipients)
+	return nil
+}
+
+func unique(uids []string) []string {
+	seen := make(map[string]bool, len(uids))
+	result := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		if uid != "" && !seen[uid] {
+			seen[uid] = true
+			result = append(result, uid)
+		}
+	}
+	return result
+}
+
+// CreateGroup creates a group owned by the creator
+func (s *Service) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse("", req.Seq)
+	if !validGroupName(req.Name) {
+		return failGroupResponse(rsp, pb.GroupResponse_INVALID_ARGUMENT), nil
+	}
+	members := unique(append([]string{req.Uid}, req.Members...))
+	for _, uid := range members {
+		if !s.Dao.IsUserValid(uid) {
+			rsp.Status = int32(pb.GroupResponse_USER_INVALID)
+			rsp.Msg = "user invalid: " + uid
+			return rsp, nil
+		}
+	}
+	group, err := newGroupID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Dao.CreateGroup(group, req.Name, req.Open, req.Uid, members[1:]); err != nil {
+		return nil, err
+	}
+	rsp.Group = group
+	notice := &model.GroupNotice{Type: model.GroupCreated, Operator: req.Uid, Members: members[1:], Name: req.Name}
+	if err := s.notifyGroup(group, notice, members); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// RenameGroup changes the group name, only admins can do it
+func (s *Service) RenameGroup(ctx context.Context, req *pb.RenameGroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	if !validGroupName(req.Name) {
+		return failGroupResponse(rsp, pb.GroupResponse_INVALID_ARGUMENT), nil
+	}
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	if err := s.Dao.RenameGroup(req.Group, req.Name); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.GroupRenamed, Operator: req.Uid, Name: req.Name}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// DisbandGroup deletes the group, only the owner can do it
+func (s *Service) DisbandGroup(ctx context.Context, req *pb.GroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	members := s.Dao.GetUserFromGroup(req.Group)
+	if err := s.Dao.DeleteGroup(req.Group); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.GroupDisbanded, Operator: req.Uid}
+	if err := s.notifyGroup(req.Group, notice, members); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// JoinGroup adds the user to an open group, other groups are only joined
+// by invitation
+func (s *Service) JoinGroup(ctx context.Context, req *pb.GroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleMember)
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case pb.GroupResponse_SUCCESS:
+		return failGroupResponse(rsp, pb.GroupResponse_ALREADY_MEMBER), nil
+	case pb.GroupResponse_GROUP_NOT_FOUND:
+		return failGroupResponse(rsp, status), nil
+	}
+	g, err := s.Dao.GetGroup(req.Group)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return failGroupResponse(rsp, pb.GroupResponse_GROUP_NOT_FOUND), nil
+	}
+	if !g.Open {
+		rsp.Status = int32(pb.GroupResponse_PERMISSION_DENIED)
+		rsp.Msg = "group is not open, members have to invite the user"
+		return rsp, nil
+	}
+	if err := s.Dao.AddGroupMembers(req.Group, []string{req.Uid}); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.MemberJoined, Operator: req.Uid, Members: []string{req.Uid}}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// LeaveGroup removes the user from the group, the owner has to transfer the
+// group first
+func (s *Service) LeaveGroup(ctx context.Context, req *pb.GroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	role, status, err := s.requireRole(req.Group, req.Uid, model.RoleMember)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	if role == model.RoleOwner {
+		rsp.Status = int32(pb.GroupResponse_PERMISSION_DENIED)
+		rsp.Msg = "owner must transfer the group before leaving"
+		return rsp, nil
+	}
+	if err := s.Dao.RemoveGroupMembers(req.Group, []string{req.Uid}); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.MemberLeft, Operator: req.Uid, Members: []string{req.Uid}}
+	recipients := append(s.Dao.GetUserFromGroup(req.Group), req.Uid)
+	if err := s.notifyGroup(req.Group, notice, recipients); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (s *Service) InviteGroupMembers(ctx context.Context, req *pb.GroupMembersRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleMember)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	invited := make([]string, 0, len(req.Members))
+	for _, uid := range unique(req.Members) {
+		if !s.Dao.IsUserValid(uid) {
+			rsp.Status = int32(pb.GroupResponse_USER_INVALID)
+			rsp.Msg = "user invalid: " + uid
+			return rsp, nil
+		}
+		if !s.Dao.IsUserInGroup(uid, req.Group) {
+			invited = append(invited, uid)
+		}
+	}
+	if len(invited) == 0 {
+		return failGroupResponse(rsp, pb.GroupResponse_ALREADY_MEMBER), nil
+	}
+	if err := s.Dao.AddGroupMembers(req.Group, invited); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.MemberInvited, Operator: req.Uid, Members: invited}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// RemoveGroupMembers kicks members out of the group, admins can only kick
+// members with a lower role than their own
+func (s *Service) RemoveGroupMembers(ctx context.Context, req *pb.GroupMembersRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	role, status, err := s.requireRole(req.Group, req.Uid, model.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	removed := make([]string, 0, len(req.Members))
+	for _, uid := range unique(req.Members) {
+		r, ok, err := s.Dao.GetMemberRole(uid, req.Group)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if r >= role {
+			rsp.Status = int32(pb.GroupResponse_PERMISSION_DENIED)
+			rsp.Msg = "can not remove " + uid
+			return rsp, nil
+		}
+		removed = append(removed, uid)
+	}
+	if len(removed) == 0 {
+		return failGroupResponse(rsp, pb.GroupResponse_NOT_MEMBER), nil
+	}
+	if err := s.Dao.RemoveGroupMembers(req.Group, removed); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.MemberRemoved, Operator: req.Uid, Members: removed}
+	recipients := append(s.Dao.GetUserFromGroup(req.Group), removed...)
+	if err := s.notifyGroup(req.Group, notice, recipients); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// MuteGroup mutes or unmutes the group, only admins can do it
+func (s *Service) MuteGroup(ctx context.Context, req *pb.MuteGroupRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	if err := s.Dao.MuteGroup(req.Group, req.Muted); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.GroupUnmuted, Operator: req.Uid}
+	if req.Muted {
+		notice.Type = model.GroupMuted
+	}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// SetGroupAdmin grants or revokes the admin role, only the owner can do it
+func (s *Service) SetGroupAdmin(ctx context.Context, req *pb.SetGroupAdminRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	role, ok, err := s.Dao.GetMemberRole(req.Member, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return failGroupResponse(rsp, pb.GroupResponse_NOT_MEMBER), nil
+	}
+	if role == model.RoleOwner {
+		return failGroupResponse(rsp, pb.GroupResponse_INVALID_ARGUMENT), nil
+	}
+	notice := &model.GroupNotice{Type: model.AdminRemoved, Operator: req.Uid, Members: []string{req.Member}}
+	role = model.RoleMember
+	if req.Admin {
+		notice.Type = model.AdminAdded
+		role = model.RoleAdmin
+	}
+	if err := s.Dao.SetMemberRole(req.Group, req.Member, role); err != nil {
+		return nil, err
+	}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// TransferGroupOwner makes another member the owner, the previous owner
+// becomes an admin
+func (s *Service) TransferGroupOwner(ctx context.Context, req *pb.TransferGroupOwnerRequest) (*pb.GroupResponse, error) {
+	rsp := newGroupResponse(req.Group, req.Seq)
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		return failGroupResponse(rsp, status), nil
+	}
+	if req.Owner == req.Uid {
+		return failGroupResponse(rsp, pb.GroupResponse_INVALID_ARGUMENT), nil
+	}
+	if !s.Dao.IsUserInGroup(req.Owner, req.Group) {
+		return failGroupResponse(rsp, pb.GroupResponse_NOT_MEMBER), nil
+	}
+	if err := s.Dao.TransferGroupOwner(req.Group, req.Uid, req.Owner); err != nil {
+		return nil, err
+	}
+	notice := &model.GroupNotice{Type: model.OwnerChanged, Operator: req.Uid, Members: []string{req.Owner}}
+	if err := s.notifyGroup(req.Group, notice, s.Dao.GetUserFromGroup(req.Group)); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (s *Service) ListGroupMembers(ctx context.Context, req *pb.GroupRequest) (*pb.ListGroupMembersResponse, error) {
+	rsp := &pb.ListGroupMembersResponse{
+		Status: int32(pb.GroupResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	_, status, err := s.requireRole(req.Group, req.Uid, model.RoleMember)
+	if err != nil {
+		return nil, err
+	}
+	if status != pb.GroupResponse_SUCCESS {
+		rsp.Status = int32(status)
+		rsp.Msg = status.String()
+		return rsp, nil
+	}
+	members, err := s.Dao.GetGroupMembers(req.Group)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		rsp.Members = append(rsp.Members, &pb.GroupMember{Uid: m.UID, Role: pb.GroupRole(m.Role)})
+	}
+	return rsp, nil
+}
+
+func (s *Service) ListUserGroups(ctx context.Context, req *pb.ListUserGroupsRequest) (*pb.ListUserGroupsResponse, error) {
+	rsp := &pb.ListUserGroupsResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	groups, err := s.Dao.GetGroupsOfUser(req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		rsp.Groups = append(rsp.Groups, &pb.GroupInfo{
+			Group: g.GroupID,
+			Name:  g.GroupName,
+			Role:  pb.GroupRole(g.Role),
+			Muted: g.Muted,
+		})
+	}
+	return rsp, nil
+}
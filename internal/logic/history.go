@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// History returns a page of the messages of a conversation of the user
+// before or after the cursor.
+func (s *Service) History(ctx context.Context, req *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	rsp := &pb.HistoryResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	convID, err := s.conversationOf(req.Uid, req.Peer, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	cursor := req.CursorSeq
+	if cursor == 0 && req.CursorMsgId != 0 {
+		msg, err := s.Dao.GetMessage(req.CursorMsgId)
+		if err != nil {
+			return nil, err
+		}
+		if msg == nil || msg.ConvID != convID {
+			return nil, status.Errorf(codes.InvalidArgument, "message %d is not in the conversation", req.CursorMsgId)
+		}
+		cursor = msg.ConvSeq
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+	forward := req.Direction == pb.HistoryRequest_FORWARD
+	// one more message than asked tells if there is another page
+	msgs, err := s.Dao.GetHistory(convID, cursor, forward, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) > int(limit) {
+		msgs = msgs[:limit]
+		rsp.HasMore = true
+	}
+	for _, msg := range msgs {
+		rsp.Msg = append(rsp.Msg, toPullMsg(msg))
+	}
+	rsp.NextCursorSeq = cursor
+	if len(msgs) > 0 {
+		rsp.NextCursorSeq = msgs[len(msgs)-1].ConvSeq
+	}
+	return rsp, nil
+}
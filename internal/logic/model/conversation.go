@@ -0,0 +1,18 @@
+package model
+
+// ImConversation is a mapping object for im_conversation table in postgresql
+type ImConversation struct {
+	ID           int64  `json:"id" db:"id"`
+	UID          string `json:"u_id" db:"u_id"`
+	ConvID       string `json:"conv_id" db:"conv_id"`
+	Peer         string `json:"peer" db:"peer"`
+	GroupID      string `json:"group_id" db:"group_id"`
+	LastMsgID    int64  `json:"last_msg_id" db:"last_msg_id"`
+	LastFrom     string `json:"last_from" db:"last_from"`
+	LastContent  string `json:"last_content" db:"last_content"`
+	LastMsgType  int    `json:"last_msg_type" db:"last_msg_type"`
+	LastRecalled bool   `json:"last_recalled" db:"last_recalled"`
+	LastSeq      int64  `json:"last_seq" db:"last_seq"`
+	LastTime     int64  `json:"last_time" db:"last_time"`
+	Unread       int32  `json:"unread" db:"unread"`
+}
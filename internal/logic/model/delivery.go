@@ -0,0 +1,8 @@
+package model
+
+// Delivery is a message pushed to a recipient, pending until the recipient
+// acks it
+type Delivery struct {
+	MsgID int64
+	UID   string
+}
@@ -0,0 +1,55 @@
+package model
+
+// ImGroup is a mapping object for im_group table in postgresql
+type ImGroup struct {
+	ID        int64  `json:"id" db:"id"`
+	GroupID   string `json:"group_id" db:"group_id"`
+	GroupName string `json:"group_name" db:"group_name"`
+	Muted     bool   `json:"muted" db:"muted"`
+	Open      bool   `json:"open" db:"open"`
+}
+
+// Roles of group members, stored in the role column of im_user_group
+const (
+	RoleMember = iota
+	RoleAdmin
+	RoleOwner
+)
+
+// ImUserGroup is a mapping object for im_user_group table in postgresql
+type ImUserGroup struct {
+	ID      int64  `json:"id" db:"id"`
+	UID     string `json:"u_id" db:"u_id"`
+	GroupID string `json:"group_id" db:"group_id"`
+	Role    int    `json:"role" db:"role"`
+}
+
+// UserGroup is a group with the role of a member in it
+type UserGroup struct {
+	ImGroup
+	Role int `json:"role" db:"role"`
+}
+
+// Types of group notices
+const (
+	GroupCreated   = "created"
+	GroupRenamed   = "renamed"
+	GroupDisbanded = "disbanded"
+	MemberJoined   = "joined"
+	MemberLeft     = "left"
+	MemberInvited  = "invited"
+	MemberRemoved  = "removed"
+	GroupMuted     = "muted"
+	GroupUnmuted   = "unmuted"
+	AdminAdded     = "admin_added"
+	AdminRemoved   = "admin_removed"
+	OwnerChanged   = "owner_changed"
+)
+
+// GroupNotice is the content of a group system message
+type GroupNotice struct {
+	Type     string   `json:"type"`
+	Operator string   `json:"operator"`
+	Members  []string `json:"members,omitempty"`
+	Name     string   `json:"name,omitempty"`
+}
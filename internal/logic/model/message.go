@@ -1,12 +1,42 @@
 package model
 
+import "strconv"
+
 // ImMessageSend is a mapping object for im_message_send table in postgresql
 type ImMessageSend struct {
-	MsgID int64 `json:"msg_id" db:"msg_id"`
-	MsgFrom string `json:"msg_from" db:"msg_from"`
-	MsgTo string `json:"msg_to" db:"msg_to"`
-	MsgSeq int64 `json:"msg_seq" db:"msg_seq"`
-	MsgContent string `json:"msg_content" db:"msg_content"`
-	SendTime int64 `json:"send_time" db:"send_time"`
-	MsgType int `json:"msg_type" db:"msg_type"`
+	MsgID       int64  `json:"msg_id" db:"msg_id"`
+	MsgFrom     string `json:"msg_from" db:"msg_from"`
+	MsgTo       string `json:"msg_to" db:"msg_to"`
+	MsgSeq      int64  `json:"msg_seq" db:"msg_seq"`
+	MsgContent  string `json:"msg_content" db:"msg_content"`
+	SendTime    int64  `json:"send_time" db:"send_time"`
+	MsgType     int    `json:"msg_type" db:"msg_type"`
+	ClientMsgID string `json:"client_msg_id" db:"client_msg_id"`
+	ConvID      string `json:"conv_id" db:"conv_id"`
+	ConvSeq     int64  `json:"conv_seq" db:"conv_seq"`
+	Recalled    bool   `json:"recalled" db:"recalled"`
+	ContentType int    `json:"content_type" db:"content_type"`
+	Payload     string `json:"payload" db:"payload"`
+}
+
+// ReadCount is the number of recipients that read a group message
+type ReadCount struct {
+	MsgID     int64 `json:"msg_id" db:"msg_id"`
+	ReadCount int32 `json:"read_count" db:"read_count"`
+	Total     int32 `json:"total" db:"total"`
+}
+
+// C2CConversation returns the conversation id of two users, the same for
+// both directions. The length of the first uid is part of the id, uids may
+// contain ":" and two pairs of users never share an id.
+func C2CConversation(a string, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+	return "c2c:" + strconv.Itoa(len(a)) + ":" + a + ":" + b
+}
+
+// GroupConversation returns the conversation id of a group
+func GroupConversation(group string) string {
+	return "group:" + group
 }
@@ -0,0 +1,16 @@
+package model
+
+import "testing"
+
+func TestC2CConversation(t *testing.T) {
+	if C2CConversation("alice", "bob") != C2CConversation("bob", "alice") {
+		t.Error("conversation id depends on the direction")
+	}
+	if id := C2CConversation("alice", "bob"); id != "c2c:5:alice:bob" {
+		t.Errorf("C2CConversation() = %q", id)
+	}
+	// without the length both pairs are c2c:a:b:c
+	if C2CConversation("a", "b:c") == C2CConversation("a:b", "c") {
+		t.Error("different users share a conversation id")
+	}
+}
@@ -0,0 +1,16 @@
+package model
+
+// Presence states of a user, the same values as the PresenceState enum
+const (
+	PresenceOffline = 0
+	PresenceOnline  = 1
+	PresenceAway    = 2
+)
+
+// Presence is the aggregated state of the devices of a user, LastSeen is the
+// last time in milliseconds the user was seen online or away
+type Presence struct {
+	UID      string
+	State    int32
+	LastSeen int64
+}
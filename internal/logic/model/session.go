@@ -0,0 +1,11 @@
+package model
+
+// Session is a logged in device of a user, sessions of a user are stored in
+// a redis hash keyed by the user id with one field per device. Gate is the id
+// the gate registered its push address under.
+type Session struct {
+	DeviceID  string `json:"device_id"`
+	Platform  string `json:"platform"`
+	Gate      string `json:"gate"`
+	LoginTime int64  `json:"login_time"`
+}
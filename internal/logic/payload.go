@@ -0,0 +1,179 @@
+package logic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/RainJoe/mim/internal/blobid"
+	"github.com/RainJoe/mim/internal/logic/model"
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+)
+
+const (
+	// maxTextLength is the number of characters a text message may have
+	maxTextLength = 5000
+	// defaultMaxPayloadSize is used when MaxPayloadSize is not configured
+	defaultMaxPayloadSize = 32 * 1024
+)
+
+var errInvalidPayload = errors.New("invalid payload")
+
+// contentTypeOf returns the content type matching the field set in the payload
+func contentTypeOf(p *pb.Payload) pb.ContentType {
+	switch p.Body.(type) {
+	case *pb.Payload_Image:
+		return pb.ContentType_IMAGE
+	case *pb.Payload_File:
+		return pb.ContentType_FILE
+	case *pb.Payload_Location:
+		return pb.ContentType_LOCATION
+	case *pb.Payload_Custom:
+		return pb.ContentType_CUSTOM
+	}
+	return pb.ContentType_TEXT
+}
+
+func invalidPayload(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", errInvalidPayload, fmt.Sprintf(format, a...))
+}
+
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validSource reports whether exactly one of the url and the id of a blob
+// uploaded to the gate is set and valid
+func validSource(u string, blobID string) bool {
+	if blobID != "" {
+		return u == "" && blobid.Valid(blobID)
+	}
+	return validURL(u)
+}
+
+// validatePayload checks the payload matches the content type and its fields
+// are valid for the type
+func (s *Service) validatePayload(contentType pb.ContentType, p *pb.Payload) error {
+	if p.Body == nil {
+		return invalidPayload("empty payload")
+	}
+	if t := contentTypeOf(p); t != contentType {
+		return invalidPayload("content type %s does not match payload %s", contentType, t)
+	}
+	maxSize := s.Conf.LogicServer.MaxPayloadSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxPayloadSize
+	}
+	if size := proto.Size(p); size > maxSize {
+		return invalidPayload("payload of %d bytes exceeds %d", size, maxSize)
+	}
+	switch body := p.Body.(type) {
+	case *pb.Payload_Text:
+		n := utf8.RuneCountInString(body.Text.GetText())
+		if n == 0 || n > maxTextLength {
+			return invalidPayload("text length %d not in [1, %d]", n, maxTextLength)
+		}
+	case *pb.Payload_Image:
+		img := body.Image
+		if img == nil || !validSource(img.Url, img.BlobId) {
+			return invalidPayload("image url or blob id required")
+		}
+		if img.ThumbnailUrl != "" && !validURL(img.ThumbnailUrl) {
+			return invalidPayload("bad thumbnail url")
+		}
+		if img.Width < 0 || img.Height < 0 || img.Size < 0 {
+			return invalidPayload("negative image size")
+		}
+	case *pb.Payload_File:
+		f := body.File
+		if f == nil || !validSource(f.Url, f.BlobId) {
+			return invalidPayload("file url or blob id required")
+		}
+		if f.Name == "" || f.Size <= 0 {
+			return invalidPayload("file name and size required")
+		}
+	case *pb.Payload_Location:
+		l := body.Location
+		if l == nil || l.Latitude < -90 || l.Latitude > 90 || l.Longitude < -180 || l.Longitude > 180 {
+			return invalidPayload("coordinates out of range")
+		}
+	case *pb.Payload_Custom:
+		c := body.Custom
+		if c == nil || c.Type == "" {
+			return invalidPayload("custom type required")
+		}
+		if !json.Valid([]byte(c.Data)) {
+			return invalidPayload("custom data is not json")
+		}
+	}
+	return nil
+}
+
+// summary is the text kept in msg_content for the conversation list and
+// clients that do not know the payload
+func summary(p *pb.Payload) string {
+	switch body := p.Body.(type) {
+	case *pb.Payload_Text:
+		return body.Text.Text
+	case *pb.Payload_Image:
+		return "[image]"
+	case *pb.Payload_File:
+		return "[file] " + body.File.Name
+	case *pb.Payload_Location:
+		return "[location] " + body.Location.Address
+	case *pb.Payload_Custom:
+		return "[custom] " + body.Custom.Type
+	}
+	return ""
+}
+
+// setPayload validates the payload of a sent message and stores it in the
+// message. Messages without payload are text messages with the content.
+func (s *Service) setPayload(msg *model.ImMessageSend, contentType pb.ContentType, p *pb.Payload, content string) error {
+	if p == nil || p.Body == nil {
+		p = &pb.Payload{Body: &pb.Payload_Text{Text: &pb.TextPayload{Text: content}}}
+	}
+	if err := s.validatePayload(contentType, p); err != nil {
+		return err
+	}
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(p)
+	if err != nil {
+		return err
+	}
+	msg.MsgContent = summary(p)
+	msg.ContentType = int(contentType)
+	msg.Payload = data
+	return nil
+}
+
+// pullPayload returns the stored payload of the message, nil if it has none
+func pullPayload(msg *model.ImMessageSend) *pb.Payload {
+	if msg.Payload == "" || msg.Payload == "{}" {
+		return nil
+	}
+	var p pb.Payload
+	if err := jsonpb.UnmarshalString(msg.Payload, &p); err != nil {
+		return nil
+	}
+	return &p
+}
+
+// pushPayload returns the stored payload of the message for pushes, nil if it
+// has none
+func pushPayload(msg *model.ImMessageSend) *pbpush.Payload {
+	if msg.Payload == "" || msg.Payload == "{}" {
+		return nil
+	}
+	var p pbpush.Payload
+	if err := jsonpb.UnmarshalString(msg.Payload, &p); err != nil {
+		return nil
+	}
+	return &p
+}
@@ -0,0 +1,248 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+const (
+	// maxPresenceUsers is the number of users one presence request may name
+	maxPresenceUsers = 200
+	// presenceSweepInterval is how often expired devices are looked for
+	presenceSweepInterval = time.Second
+	presenceSweepBatch    = 500
+)
+
+func (s *Service) presenceTTL() time.Duration {
+	return time.Duration(s.Conf.LogicServer.PresenceTTL) * time.Second
+}
+
+// Heartbeat refreshes the devices still connected to the reporting gate and
+// removes the ones that disconnected from it
+func (s *Service) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.Response, error) {
+	rsp := &pb.Response{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	if req.GateId == "" {
+		return rsp, nil
+	}
+	if len(req.Online) > 0 {
+		devices := make([][2]string, 0, len(req.Online))
+		for _, dev := range req.Online {
+			devices = append(devices, [2]string{dev.Uid, dev.DeviceId})
+		}
+		states, err := s.Dao.RefreshPresences(devices, req.GateId, s.presenceTTL())
+		if err != nil {
+			return nil, err
+		}
+		for i, st := range states {
+			s.presenceChanged(devices[i][0], st[0], st[1])
+		}
+	}
+	for _, dev := range req.Offline {
+		before, after, err := s.Dao.RemovePresence(dev.Uid, dev.DeviceId, req.GateId)
+		if err != nil {
+			return nil, err
+		}
+		s.presenceChanged(dev.Uid, before, after)
+	}
+	return rsp, nil
+}
+
+// SetPresence switches the device between online and away
+func (s *Service) SetPresence(ctx context.Context, req *pb.SetPresenceRequest) (*pb.PresenceResponse, error) {
+	rsp := &pb.PresenceResponse{
+		Status: int32(pb.PresenceResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	if req.GateId == "" || req.DeviceId == "" || (req.State != pb.PresenceState_ONLINE && req.State != pb.PresenceState_AWAY) {
+		rsp.Status = int32(pb.PresenceResponse_INVALID_ARGUMENT)
+		rsp.Msg = pb.PresenceResponse_INVALID_ARGUMENT.String()
+		return rsp, nil
+	}
+	before, after, err := s.Dao.SetPresence(req.Uid, req.DeviceId, int32(req.State), req.GateId, s.presenceTTL())
+	if err != nil {
+		return nil, err
+	}
+	s.presenceChanged(req.Uid, before, after)
+	return rsp, nil
+}
+
+// GetPresence returns the presence of the users
+func (s *Service) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.PresenceResponse, error) {
+	rsp := &pb.PresenceResponse{
+		Status: int32(pb.PresenceResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	uids := unique(req.Uids)
+	if len(uids) == 0 || len(uids) > maxPresenceUsers {
+		rsp.Status = int32(pb.PresenceResponse_INVALID_ARGUMENT)
+		rsp.Msg = pb.PresenceResponse_INVALID_ARGUMENT.String()
+		return rsp, nil
+	}
+	presences, err := s.Dao.GetPresences(uids)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Presences = toPresences(presences)
+	return rsp, nil
+}
+
+// SubscribePresence subscribes the user to the presence changes of the users
+// and returns their current presence. Users can only subscribe to users they
+// had a conversation with or share a group with.
+func (s *Service) SubscribePresence(ctx context.Context, req *pb.SubscribePresenceRequest) (*pb.PresenceResponse, error) {
+	rsp := &pb.PresenceResponse{
+		Status: int32(pb.PresenceResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	fail := func(status pb.PresenceResponse_Status) (*pb.PresenceResponse, error) {
+		rsp.Status = int32(status)
+		rsp.Msg = status.String()
+		return rsp, nil
+	}
+	uids := make([]string, 0, len(req.Uids))
+	for _, uid := range unique(req.Uids) {
+		if uid != req.Uid {
+			uids = append(uids, uid)
+		}
+	}
+	if len(uids) == 0 || len(uids) > maxPresenceUsers {
+		return fail(pb.PresenceResponse_INVALID_ARGUMENT)
+	}
+	related, err := s.Dao.GetRelatedUsers(req.Uid, uids)
+	if err != nil {
+		return nil, err
+	}
+	if len(related) != len(uids) {
+		return fail(pb.PresenceResponse_NOT_RELATED)
+	}
+	if max := s.Conf.LogicServer.MaxPresenceSubscriptions; max > 0 {
+		n, err := s.Dao.GetPresenceSubscriptionCount(req.Uid)
+		if err != nil {
+			return nil, err
+		}
+		if n+len(uids) > max {
+			return fail(pb.PresenceResponse_TOO_MANY_SUBSCRIPTIONS)
+		}
+	}
+	if err := s.Dao.SubscribePresence(req.Uid, uids); err != nil {
+		return nil, err
+	}
+	presences, err := s.Dao.GetPresences(uids)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Presences = toPresences(presences)
+	return rsp, nil
+}
+
+// UnsubscribePresence cancels the subscriptions of the user to the users
+func (s *Service) UnsubscribePresence(ctx context.Context, req *pb.SubscribePresenceRequest) (*pb.PresenceResponse, error) {
+	rsp := &pb.PresenceResponse{
+		Status: int32(pb.PresenceResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	uids := unique(req.Uids)
+	if len(uids) == 0 || len(uids) > maxPresenceUsers {
+		rsp.Status = int32(pb.PresenceResponse_INVALID_ARGUMENT)
+		rsp.Msg = pb.PresenceResponse_INVALID_ARGUMENT.String()
+		return rsp, nil
+	}
+	if err := s.Dao.UnsubscribePresence(req.Uid, uids); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func toPresences(presences []*model.Presence) []*pb.Presence {
+	result := make([]*pb.Presence, 0, len(presences))
+	for _, p := range presences {
+		result = append(result, &pb.Presence{
+			Uid:      p.UID,
+			State:    pb.PresenceState(p.State),
+			LastSeen: p.LastSeen,
+		})
+	}
+	return result
+}
+
+// SweepPresence removes the devices whose gates stopped refreshing them and
+// notifies the subscribers of the users that went offline. Expiring a device
+// is atomic, several logic servers can sweep at the same time.
+func (s *Service) SweepPresence() {
+	ticker := time.NewTicker(presenceSweepInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for {
+			devices, err := s.Dao.GetExpiredPresences(presenceSweepBatch)
+			if err != nil {
+				log.Error(err)
+				break
+			}
+			for _, dev := range devices {
+				before, after, err := s.Dao.ExpirePresence(dev[0], dev[1])
+				if err != nil {
+					log.Error(err)
+					continue
+				}
+				s.presenceChanged(dev[0], before, after)
+			}
+			if len(devices) < presenceSweepBatch {
+				break
+			}
+		}
+	}
+}
+
+// presenceChanged pushes the new state of the user to its subscribers
+func (s *Service) presenceChanged(uid string, before int32, after int32) {
+	if before == after {
+		return
+	}
+	subscribers, err := s.Dao.GetPresenceSubscribers(uid)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if len(subscribers) == 0 {
+		return
+	}
+	now := time.Now().UnixNano() / 1e6
+	notice := &pbpush.PresenceNotice{
+		Uid:      uid,
+		State:    pbpush.PresenceState(after),
+		LastSeen: now,
+		Ts:       now,
+	}
+	go s.presencePush(notice, subscribers)
+}
+
+func (s *Service) presencePush(notice *pbpush.PresenceNotice, subscribers []string) {
+	err := s.fanOut(subscribers, func(c pbpush.PushServiceClient, uids []string) error {
+		req := &pbpush.PresencePushRequest{
+			To:     uids,
+			Notice: notice,
+			Ts:     time.Now().UnixNano() / 1e6,
+		}
+		_, err := c.Presence(context.TODO(), req)
+		return err
+	})
+	if err != nil && err != errUserOffline {
+		log.Error(err)
+	}
+}
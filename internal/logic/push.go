@@ -0,0 +1,165 @@
+package logic
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// fanOut groups the recipients by the gates they are connected to and calls
+// push once per gate with the recipients connected to it. It returns
+// errUserOffline when no recipient is connected, otherwise the last error of
+// push.
+func (s *Service) fanOut(recipients []string, push func(c pbpush.PushServiceClient, uids []string) error) error {
+	table := make(map[string][]string)
+	for _, uid := range recipients {
+		for _, gate := range s.gatesOf(uid) {
+			table[gate] = append(table[gate], uid)
+		}
+	}
+	if len(table) == 0 {
+		return errUserOffline
+	}
+	var lastErr error
+	for gate, uids := range table {
+		c, err := s.Push.Get(gate)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if err := push(c, uids); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// c2cPush pushes the message to every gate the recipient is connected to.
+func (s *Service) c2cPush(req *pbpush.C2CPushRequest) error {
+	return s.fanOut([]string{req.To}, func(c pbpush.PushServiceClient, uids []string) error {
+		_, err := c.C2CPush(context.TODO(), req)
+		return err
+	})
+}
+
+// sendGroupMessage pushes the saved group message to the recipients online
+// and tracks the deliveries.
+func (s *Service) sendGroupMessage(msg *model.ImMessageSend, recipients []string) {
+	table := make(map[string][]string)
+	online := make([]string, 0)
+	for _, uid := range recipients {
+		gates := s.gatesOf(uid)
+		if len(gates) > 0 {
+			online = append(online, uid)
+		}
+		for _, gate := range gates {
+			table[gate] = append(table[gate], uid)
+		}
+	}
+	c2gPush := &pbpush.C2GPushRequest{
+		From:        msg.MsgFrom,
+		Seq:         msg.MsgSeq + 1,
+		Group:       msg.MsgTo,
+		Content:     msg.MsgContent,
+		MsgId:       msg.MsgID,
+		Ts:          time.Now().UnixNano() / 1e6,
+		ConvSeq:     msg.ConvSeq,
+		MsgType:     int32(msg.MsgType),
+		ContentType: pbpush.ContentType(msg.ContentType),
+		Payload:     pushPayload(msg),
+	}
+	go func() {
+		for _, uid := range online {
+			uid := uid
+			s.Delivery.Track(msg.MsgID, uid, func() error { return s.c2gPush(uid, c2gPush) })
+		}
+		for gate, ids := range table {
+			// members of a failed batch stay tracked, the redelivery
+			// pushes to them one by one and gives up on the offline ones
+			if err := s.batchPush(gate, ids, c2gPush); err != nil {
+				log.Error(err)
+			}
+		}
+	}()
+}
+
+// c2gPush pushes the group message to a single member, used to redeliver
+// messages the member did not ack.
+func (s *Service) c2gPush(uid string, msg *pbpush.C2GPushRequest) error {
+	req := &pbpush.C2GPushRequest{
+		From:        msg.From,
+		To:          uid,
+		Group:       msg.Group,
+		Content:     msg.Content,
+		MsgId:       msg.MsgId,
+		Ts:          time.Now().UnixNano() / 1e6,
+		Seq:         msg.Seq,
+		ConvSeq:     msg.ConvSeq,
+		MsgType:     msg.MsgType,
+		ContentType: msg.ContentType,
+		Payload:     msg.Payload,
+	}
+	return s.fanOut([]string{uid}, func(c pbpush.PushServiceClient, uids []string) error {
+		_, err := c.C2GPush(context.TODO(), req)
+		return err
+	})
+}
+
+// batchPush pushes the message to the users on the gate. Users are
+// split into batches of PushBatchSize, several batches are sent over one
+// Push stream instead of one BatchPush call each.
+func (s *Service) batchPush(gate string, uids []string, msg *pbpush.C2GPushRequest) error {
+	c, err := s.Push.Get(gate)
+	if err != nil {
+		return err
+	}
+	size := s.Conf.LogicServer.PushBatchSize
+	if size <= 0 {
+		size = len(uids)
+	}
+	batches := make([]*pbpush.BatchPushRequest, 0, len(uids)/size+1)
+	for i := 0; i < len(uids); i += size {
+		end := i + size
+		if end > len(uids) {
+			end = len(uids)
+		}
+		batches = append(batches, &pbpush.BatchPushRequest{
+			To:  uids[i:end],
+			Msg: msg,
+			Ts:  time.Now().UnixNano() / 1e6,
+			Seq: int64(len(batches)),
+		})
+	}
+	if len(batches) == 1 {
+		_, err := c.BatchPush(context.TODO(), batches[0])
+		return err
+	}
+	// cancel finishes the stream when a send fails before all replies
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	stream, err := c.Push(ctx)
+	if err != nil {
+		return err
+	}
+	for _, batch := range batches {
+		if err := stream.Send(batch); err != nil {
+			return err
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/RainJoe/mim/internal/registry"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+var errPushClientsClosed = errors.New("push clients closed")
+
+// PushClients keeps one long-lived grpc connection per gate. The push address
+// of a gate is resolved from the gate registry by its id. Connections are
+// dialed lazily, grpc reconnects them with exponential backoff, connections
+// of gates that were not pushed to within the idle timeout and have no call
+// in flight are closed, and so are the ones of gates that left the registry
+// or moved to another address.
+type PushClients struct {
+	idleTimeout time.Duration
+	gates       *registry.Watcher
+
+	mu      sync.Mutex
+	clients map[string]*pushClient
+	closed  bool
+	done    chan struct{}
+
+	dials     uint64
+	dialErrs  uint64
+	evictions uint64
+}
+
+type pushClient struct {
+	pbpush.PushServiceClient
+	conn *grpc.ClientConn
+	addr string
+	// unix nano of the last Get or finished call
+	lastUsed int64
+	// calls in flight, a connection in use is never idle
+	inflight int32
+}
+
+func (c *pushClient) begin() {
+	atomic.AddInt32(&c.inflight, 1)
+	atomic.StoreInt64(&c.lastUsed, time.Now().UnixNano())
+}
+
+func (c *pushClient) end() {
+	atomic.StoreInt64(&c.lastUsed, time.Now().UnixNano())
+	atomic.AddInt32(&c.inflight, -1)
+}
+
+// idle reports whether the connection was not used since deadline.
+func (c *pushClient) idle(deadline int64) bool {
+	return atomic.LoadInt32(&c.inflight) == 0 && atomic.LoadInt64(&c.lastUsed) < deadline
+}
+
+// unaryInterceptor counts the call as in flight until it returned.
+func (c *pushClient) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	c.begin()
+	defer c.end()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// streamInterceptor counts a stream as in flight until it finished.
+func (c *pushClient) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	c.begin()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		c.end()
+		return nil, err
+	}
+	go func() {
+		// the context of a client stream is done when the stream finished
+		<-stream.Context().Done()
+		c.end()
+	}()
+	return stream, nil
+}
+
+// PushClientsStats is a snapshot of the push connections
+type PushClientsStats struct {
+	Conns     int
+	Dials     uint64
+	DialErrs  uint64
+	Evictions uint64
+	// connectivity state of each gate connection keyed by gate id
+	Gates map[string]string
+}
+
+// NewPushClients creates the push clients resolving gates with the watcher,
+// idle connections are checked for every half idle timeout.
+func NewPushClients(idleTimeout time.Duration, gates *registry.Watcher) *PushClients {
+	m := &PushClients{
+		idleTimeout: idleTimeout,
+		gates:       gates,
+		clients:     make(map[string]*pushClient),
+		done:        make(chan struct{}),
+	}
+	if idleTimeout > 0 {
+		go m.evictLoop()
+	}
+	return m
+}
+
+// Get returns the push client of the gate, dialing it if needed. The dial
+// does not block, rpc calls fail fast while the gate is unreachable. It
+// returns registry.ErrNotFound for gates that are not registered.
+func (m *PushClients) Get(gate string) (pbpush.PushServiceClient, error) {
+	addr, err := m.gates.Lookup(gate)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, errPushClientsClosed
+	}
+	c, ok := m.clients[gate]
+	if ok && (err == registry.ErrNotFound || err == nil && c.addr != addr) {
+		delete(m.clients, gate)
+		c.conn.Close()
+		ok = false
+	}
+	// a failed lookup keeps using the connection the gate already has
+	if ok {
+		atomic.StoreInt64(&c.lastUsed, time.Now().UnixNano())
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddUint64(&m.dials, 1)
+	c = &pushClient{addr: addr, lastUsed: time.Now().UnixNano()}
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff:           backoff.DefaultConfig,
+		MinConnectTimeout: 5 * time.Second,
+	}), grpc.WithUnaryInterceptor(c.unaryInterceptor), grpc.WithStreamInterceptor(c.streamInterceptor))
+	if err != nil {
+		atomic.AddUint64(&m.dialErrs, 1)
+		return nil, err
+	}
+	c.conn = conn
+	c.PushServiceClient = pbpush.NewPushServiceClient(conn)
+	m.clients[gate] = c
+	return c, nil
+}
+
+func (m *PushClients) evictLoop() {
+	ticker := time.NewTicker(m.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.evictIdle()
+		case <-m.done:
+			return
+		}
+	}
+}
+
+func (m *PushClients) evictIdle() {
+	deadline := time.Now().Add(-m.idleTimeout).UnixNano()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for gate, c := range m.clients {
+		if c.idle(deadline) {
+			delete(m.clients, gate)
+			atomic.AddUint64(&m.evictions, 1)
+			if err := c.conn.Close(); err != nil {
+				log.Error(err)
+			}
+		}
+	}
+}
+
+// Stats returns a snapshot of the push connections
+func (m *PushClients) Stats() PushClientsStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := PushClientsStats{
+		Conns:     len(m.clients),
+		Dials:     atomic.LoadUint64(&m.dials),
+		DialErrs:  atomic.LoadUint64(&m.dialErrs),
+		Evictions: atomic.LoadUint64(&m.evictions),
+		Gates:     make(map[string]string, len(m.clients)),
+	}
+	for gate, c := range m.clients {
+		stats.Gates[gate] = c.conn.GetState().String()
+	}
+	return stats
+}
+
+// Close closes all connections
+func (m *PushClients) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	m.closed = true
+	close(m.done)
+	for gate, c := range m.clients {
+		delete(m.clients, gate)
+		c.conn.Close()
+	}
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/model"
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// Recall marks the message as recalled and pushes a recall notice to the
+// online recipients. Senders can recall their messages, group admins any
+// message of the group, both only within the recall window.
+func (s *Service) Recall(ctx context.Context, req *pb.RecallRequest) (*pb.RecallResponse, error) {
+	rsp := &pb.RecallResponse{
+		Status: int32(pb.RecallResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	fail := func(status pb.RecallResponse_Status) (*pb.RecallResponse, error) {
+		rsp.Status = int32(status)
+		rsp.Msg = status.String()
+		return rsp, nil
+	}
+	msg, err := s.Dao.GetMessage(req.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil || msg.MsgType == GroupNoticeMessage {
+		return fail(pb.RecallResponse_NOT_FOUND)
+	}
+	if msg.MsgFrom != req.Uid {
+		if msg.MsgType != C2GMessage {
+			return fail(pb.RecallResponse_PERMISSION_DENIED)
+		}
+		role, ok, err := s.Dao.GetMemberRole(req.Uid, msg.MsgTo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || role < model.RoleAdmin {
+			return fail(pb.RecallResponse_PERMISSION_DENIED)
+		}
+	}
+	if msg.Recalled {
+		return rsp, nil
+	}
+	window := int64(s.Conf.LogicServer.RecallWindow) * 1000
+	if window > 0 && time.Now().UnixNano()/1e6-msg.SendTime > window {
+		return fail(pb.RecallResponse_EXPIRED)
+	}
+	if err := s.Dao.RecallMessage(msg.MsgID); err != nil {
+		return nil, err
+	}
+	s.Delivery.Cancel(msg.MsgID)
+	notice := &pbpush.RecallNotice{
+		Operator: req.Uid,
+		From:     msg.MsgFrom,
+		MsgId:    msg.MsgID,
+		Ts:       time.Now().UnixNano() / 1e6,
+		Seq:      req.Seq,
+	}
+	var recipients []string
+	if msg.MsgType == C2GMessage {
+		notice.Group = msg.MsgTo
+		recipients = s.Dao.GetUserFromGroup(msg.MsgTo)
+	} else {
+		notice.To = msg.MsgTo
+		recipients = []string{msg.MsgFrom, msg.MsgTo}
+	}
+	go s.recallPush(notice, recipients)
+	return rsp, nil
+}
+
+// recallPush pushes the recall notice to the gates the recipients are
+// connected to. Offline recipients see the recall when they pull.
+func (s *Service) recallPush(notice *pbpush.RecallNotice, recipients []string) {
+	err := s.fanOut(recipients, func(c pbpush.PushServiceClient, uids []string) error {
+		req := &pbpush.RecallPushRequest{
+			To:     uids,
+			Notice: notice,
+			Ts:     time.Now().UnixNano() / 1e6,
+		}
+		_, err := c.Recall(context.TODO(), req)
+		return err
+	})
+	if err != nil && err != errUserOffline {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,99 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// MarkRead marks the messages of the conversation up to the sequence number
+// read and pushes read receipts to their senders.
+func (s *Service) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	rsp := &pb.MarkReadResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	convID, err := s.conversationOf(req.Uid, req.Peer, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := s.Dao.MarkRead(req.Uid, convID, req.ConvSeq)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Count = int32(len(msgs))
+	if len(msgs) > 0 {
+		if err := s.Dao.DecrUnread(req.Uid, convID, len(msgs)); err != nil {
+			return nil, err
+		}
+	}
+	// a read message is delivered as well
+	senders := make(map[string][]int64)
+	for _, msg := range msgs {
+		s.Delivery.Ack(msg.MsgID, req.Uid)
+		if msg.MsgType == GroupNoticeMessage || msg.MsgFrom == req.Uid {
+			continue
+		}
+		senders[msg.MsgFrom] = append(senders[msg.MsgFrom], msg.MsgID)
+	}
+	for sender, ids := range senders {
+		receipt := &pbpush.ReadReceipt{
+			Reader:  req.Uid,
+			To:      sender,
+			Group:   req.Group,
+			ConvSeq: req.ConvSeq,
+			MsgIds:  ids,
+			Ts:      time.Now().UnixNano() / 1e6,
+			Seq:     req.Seq,
+		}
+		if req.Group != "" {
+			counts, err := s.Dao.GetReadCounts(req.Group, ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range counts {
+				receipt.Counts = append(receipt.Counts, &pbpush.ReadCount{MsgId: c.MsgID, ReadCount: c.ReadCount, Total: c.Total})
+			}
+		}
+		go func() {
+			if err := s.readReceiptPush(receipt); err != nil && err != errUserOffline {
+				log.Error(err)
+			}
+		}()
+	}
+	return rsp, nil
+}
+
+// GetReadCounts returns how many members read each of the group messages
+func (s *Service) GetReadCounts(ctx context.Context, req *pb.ReadCountsRequest) (*pb.ReadCountsResponse, error) {
+	rsp := &pb.ReadCountsResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	if !s.Dao.IsUserInGroup(req.Uid, req.Group) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not a member of group %s", req.Uid, req.Group)
+	}
+	counts, err := s.Dao.GetReadCounts(req.Group, req.MsgIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		rsp.Counts = append(rsp.Counts, &pb.ReadCount{MsgId: c.MsgID, ReadCount: c.ReadCount, Total: c.Total})
+	}
+	return rsp, nil
+}
+
+// readReceiptPush pushes the receipt to every gate the sender is connected to.
+// Receipts are not tracked, senders that miss them can query the read counts.
+func (s *Service) readReceiptPush(receipt *pbpush.ReadReceipt) error {
+	return s.fanOut([]string{receipt.To}, func(c pbpush.PushServiceClient, uids []string) error {
+		_, err := c.ReadReceipt(context.TODO(), receipt)
+		return err
+	})
+}
@@ -2,125 +2,153 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"github.com/RainJoe/mim/internal/logic/auth"
 	"github.com/RainJoe/mim/internal/logic/config"
 	"github.com/RainJoe/mim/internal/logic/dao"
 	"github.com/RainJoe/mim/internal/logic/model"
+	"github.com/RainJoe/mim/internal/registry"
 	pb "github.com/RainJoe/mim/pb/logic"
 	pbpush "github.com/RainJoe/mim/pb/push"
 	log "github.com/RainJoe/mim/pkg/zaplog"
 	"time"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/peer"
-	"strings"
 )
 
 const (
 	C2CMessage = iota
 	C2GMessage
+	GroupNoticeMessage
 )
-type Service struct{
-	Conf *config.Config
-	Dao *dao.Dao
-}
 
-func kickOut(s *Service, uid string, reason int32) {
-	if s.Dao.IsUserOnline(uid) {
-		addr := s.Dao.GetGateOfUser(uid)
-		c, conn, err := newGRPCPushClient(addr+s.Conf.LogicServer.PushServerAddr)
-		if err != nil {
-			log.Error(err)
-			return
-		}
-		defer conn.Close()
-		kickOutReq := &pbpush.KickOutRequest{
-			Uid: uid,
-			Reason:               reason,
-			Ts: time.Now().UnixNano() / 1e6,
-		}
-		_, err = c.KickOut(context.TODO(), kickOutReq)
-		if err != nil {
-			log.Error(err)
-		}
-		if err := s.Dao.DeleteUserFromRedis(uid); err != nil {
-			log.Error(err)
-		}
-	}
+type Service struct {
+	Conf     *config.Config
+	Dao      *dao.Dao
+	Verifier auth.Verifier
+	Push     *PushClients
+	Gates    *registry.Watcher
+	Delivery *DeliveryTracker
 }
 
 func (s *Service) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
 	rsp := &pb.AuthResponse{
 		Status: 0,
-		Msg: "Success",
-		Ts: time.Now().UnixNano() / 1e6,
-		Seq: req.Seq,
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	if err := s.Verifier.Verify(req.Uid, req.Token); err != nil {
+		switch err {
+		case auth.ErrTokenExpired:
+			rsp.Status = int32(pb.AuthResponse_TOKEN_EXPIRED)
+		case auth.ErrBadSignature:
+			rsp.Status = int32(pb.AuthResponse_BAD_SIGNATURE)
+		default:
+			rsp.Status = int32(pb.AuthResponse_TOKEN_INVALID)
+		}
+		rsp.Msg = err.Error()
+		return rsp, nil
 	}
 	if !s.Dao.IsUserValid(req.Uid) {
-		rsp.Status = 1
+		rsp.Status = int32(pb.AuthResponse_USER_INVALID)
 		rsp.Msg = "user invalid"
 		return rsp, nil
 	}
-	if pr, ok := peer.FromContext(ctx); ok {
-		addr := strings.Split(pr.Addr.String(), ":")
-		if err := s.Dao.SetUserGateInfo(req.Uid, addr[0]); err != nil {
+	if req.GateId != "" {
+		session := &model.Session{
+			DeviceID:  req.DeviceId,
+			Platform:  req.Platform,
+			Gate:      req.GateId,
+			LoginTime: time.Now().UnixNano() / 1e6,
+		}
+		for _, old := range s.sessionsToKick(req.Uid, session) {
+			kickOut(s, req.Uid, old, int32(pbpush.KickOutRequest_OTHER_LOGIN))
+		}
+		if err := s.Dao.AddUserSession(req.Uid, session); err != nil {
 			log.Error(err)
 		}
+		before, after, err := s.Dao.SetPresence(req.Uid, req.DeviceId, model.PresenceOnline, req.GateId, s.presenceTTL())
+		if err != nil {
+			log.Error(err)
+		} else {
+			s.presenceChanged(req.Uid, before, after)
+		}
 	}
 	return rsp, nil
 }
 
 func (s *Service) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
 	rsp := &pb.LogoutResponse{
-		Ts: time.Now().UnixNano() / 1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
 		Seq: req.Seq,
 	}
-	go kickOut(s, req.Uid, 0)
+	go func() {
+		for _, session := range s.Dao.GetUserSessions(req.Uid) {
+			if req.DeviceId == "" || req.DeviceId == session.DeviceID {
+				kickOut(s, req.Uid, session, int32(pbpush.KickOutRequest_LOGOUT))
+			}
+		}
+	}()
 	return rsp, nil
 }
 
-func newGRPCPushClient(addr string) (pbpush.PushServiceClient, *grpc.ClientConn, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
-	if err != nil {
-		return nil, nil, err
-	}
-	c := pbpush.NewPushServiceClient(conn)
-	return c, conn, nil
-}
-
 func (s *Service) C2CSend(ctx context.Context, req *pb.C2CSendRequest) (*pb.C2CSendResponse, error) {
 	rsp := &pb.C2CSendResponse{
-		Ts: time.Now().UnixNano() / 1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
 		Seq: req.Seq,
 	}
-	msgID, err := s.Dao.SaveSendMessage(req.From, req.To, req.Content, req.Seq, req.Ts, C2CMessage)
-	if err != nil {
+	if msgID, convSeq, ok := s.sentMessageID(req.From, req.ClientMsgId); ok {
+		rsp.MsgId = msgID
+		rsp.ConvSeq = convSeq
+		return rsp, nil
+	}
+	msg := &model.ImMessageSend{
+		MsgFrom:     req.From,
+		MsgTo:       req.To,
+		MsgSeq:      req.Seq,
+		SendTime:    time.Now().UnixNano() / 1e6,
+		MsgType:     C2CMessage,
+		ClientMsgID: req.ClientMsgId,
+		ConvID:      model.C2CConversation(req.From, req.To),
+	}
+	if err := s.setPayload(msg, req.ContentType, req.Payload, req.Content); err != nil {
+		if errors.Is(err, errInvalidPayload) {
+			rsp.Status = int32(pb.C2CSendResponse_INVALID_PAYLOAD)
+			rsp.Msg = err.Error()
+			return rsp, nil
+		}
 		return nil, err
 	}
-	err = s.Dao.SaveRecvMessage(req.From, req.To, msgID)
+	dup, err := s.Dao.SaveSendMessage(msg, []string{req.To})
 	if err != nil {
 		return nil, err
 	}
+	rsp.MsgId = msg.MsgID
+	rsp.ConvSeq = msg.ConvSeq
+	s.cacheSentMessageID(msg)
+	if dup {
+		return rsp, nil
+	}
+	c2cPushReq := &pbpush.C2CPushRequest{
+		To:          req.To,
+		From:        req.From,
+		Seq:         req.Seq + 1,
+		Content:     msg.MsgContent,
+		MsgId:       msg.MsgID,
+		Ts:          time.Now().UnixNano() / 1e6,
+		ConvSeq:     msg.ConvSeq,
+		ContentType: pbpush.ContentType(msg.ContentType),
+		Payload:     pushPayload(msg),
+	}
 	go func() {
-		if s.Dao.IsUserOnline(req.To) {
-			addr := s.Dao.GetGateOfUser(req.To)
-			c, conn, err := newGRPCPushClient(addr + s.Conf.LogicServer.PushServerAddr)
-			defer conn.Close()
-			if err != nil {
-				log.Error(err)
+		push := func() error { return s.c2cPush(c2cPushReq) }
+		s.Delivery.Track(msg.MsgID, req.To, push)
+		if err := push(); err != nil {
+			if err == errUserOffline {
+				s.Delivery.Untrack(msg.MsgID, req.To)
 				return
 			}
-			c2cPushReq := &pbpush.C2CPushRequest{
-				To:                   req.To,
-				From:                 req.From,
-				Seq:                  req.Seq+1,
-				Content:              req.Content,
-				MsgId:                msgID,
-				Ts: time.Now().UnixNano() / 1e6,
-			}
-			_, err = c.C2CPush(context.TODO(), c2cPushReq)
-			if err != nil {
-				log.Error(err)
-			}
+			// left tracked, the push is retried after the ack timeout
+			log.Error(err)
 		}
 	}()
 	return rsp, nil
@@ -128,82 +156,146 @@ func (s *Service) C2CSend(ctx context.Context, req *pb.C2CSendRequest) (*pb.C2CS
 
 func (s *Service) C2GSend(ctx context.Context, req *pb.C2GSendRequest) (*pb.C2GSendResponse, error) {
 	rsp := &pb.C2GSendResponse{
-		Ts: time.Now().UnixNano() / 1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
 		Seq: req.Seq,
 	}
-	msgID, err := s.Dao.SaveSendMessage(req.From, req.Group, req.Content, req.Seq, req.Ts, C2CMessage)
+	status, err := s.canSendToGroup(req.From, req.Group)
 	if err != nil {
 		return nil, err
 	}
-	table := make(map[string][]string)
-	for _, uid := range	s.Dao.GetUserFromGroup(req.Group) {
-		if uid == req.From {
-			continue
-		}
-		err = s.Dao.SaveRecvMessage(req.From, uid, msgID)
-		if err != nil {
-			return nil, err
-		}
-		if s.Dao.IsUserOnline(uid) {
-			addr := s.Dao.GetGateOfUser(uid) + s.Conf.LogicServer.PushServerAddr
-			table[addr] = append(table[addr], uid)
+	if status != pb.C2GSendResponse_SUCCESS {
+		rsp.Status = int32(status)
+		rsp.Msg = status.String()
+		return rsp, nil
+	}
+	if msgID, convSeq, ok := s.sentMessageID(req.From, req.ClientMsgId); ok {
+		rsp.MsgId = msgID
+		rsp.ConvSeq = convSeq
+		return rsp, nil
+	}
+	msg := &model.ImMessageSend{
+		MsgFrom:     req.From,
+		MsgTo:       req.Group,
+		MsgSeq:      req.Seq,
+		SendTime:    time.Now().UnixNano() / 1e6,
+		MsgType:     C2GMessage,
+		ClientMsgID: req.ClientMsgId,
+		ConvID:      model.GroupConversation(req.Group),
+	}
+	if err := s.setPayload(msg, req.ContentType, req.Payload, req.Content); err != nil {
+		if errors.Is(err, errInvalidPayload) {
+			rsp.Status = int32(pb.C2GSendResponse_INVALID_PAYLOAD)
+			rsp.Msg = err.Error()
+			return rsp, nil
 		}
+		return nil, err
 	}
-	go func() {
-		for addr, ids := range table {
-			c, conn, err := newGRPCPushClient(addr)
-			if err != nil {
-				log.Error(err)
-				continue
-			}
-			for _, uid := range ids {
-				c2gPush := &pbpush.C2GPushRequest{
-					From:                 req.From,
-					To:                   uid,
-					Seq:                  req.Seq+1,
-					Group:                req.Group,
-					Content:              req.Content,
-					MsgId:             	  msgID,
-					Ts: time.Now().UnixNano() / 1e6,
-				}
-				_, err := c.C2GPush(context.TODO(), c2gPush)
-				if err != nil {
-					log.Error(err)
-					continue
-				}
-			}
-			conn.Close()
+	recipients := make([]string, 0)
+	for _, uid := range s.Dao.GetUserFromGroup(req.Group) {
+		if uid != req.From {
+			recipients = append(recipients, uid)
 		}
-	}()
+	}
+	dup, err := s.Dao.SaveSendMessage(msg, recipients)
+	if err != nil {
+		return nil, err
+	}
+	rsp.MsgId = msg.MsgID
+	rsp.ConvSeq = msg.ConvSeq
+	s.cacheSentMessageID(msg)
+	if !dup {
+		s.sendGroupMessage(msg, recipients)
+	}
 	return rsp, nil
 }
 
+// canSendToGroup checks the sender is a member of the group and, when the
+// group is muted, an admin
+func (s *Service) canSendToGroup(uid string, group string) (pb.C2GSendResponse_Status, error) {
+	g, err := s.Dao.GetGroup(group)
+	if err != nil {
+		return 0, err
+	}
+	if g == nil {
+		return pb.C2GSendResponse_NOT_MEMBER, nil
+	}
+	role, ok, err := s.Dao.GetMemberRole(uid, group)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return pb.C2GSendResponse_NOT_MEMBER, nil
+	}
+	if g.Muted && role < model.RoleAdmin {
+		return pb.C2GSendResponse_MUTED, nil
+	}
+	return pb.C2GSendResponse_SUCCESS, nil
+}
+
+func toPullMsg(msg *model.ImMessageSend) *pb.PullMsg {
+	var pm pb.PullMsg
+	pm.MsgId = msg.MsgID
+	pm.Seq = msg.MsgSeq
+	pm.Ts = time.Now().UnixNano() / 1e6
+	pm.From = msg.MsgFrom
+	if msg.MsgType != C2CMessage {
+		pm.Group = msg.MsgTo
+	} else {
+		pm.To = msg.MsgTo
+	}
+	if msg.Recalled {
+		pm.Recalled = true
+	} else {
+		pm.Content = msg.MsgContent
+		pm.Payload = pullPayload(msg)
+	}
+	pm.ContentType = pb.ContentType(msg.ContentType)
+	pm.SendTime = msg.SendTime
+	pm.ConvSeq = msg.ConvSeq
+	pm.MsgType = int32(msg.MsgType)
+	return &pm
+}
+
 func (s *Service) C2SPull(ctx context.Context, req *pb.C2SPullMessageRequest) (*pb.C2SPullMessageResponse, error) {
 	rsp := &pb.C2SPullMessageResponse{
-		Ts: time.Now().UnixNano()/1e6,
+		Ts:  time.Now().UnixNano() / 1e6,
 		Seq: req.Seq,
 	}
 	msgs := make([]*model.ImMessageSend, 0)
-	err := s.Dao.GetUnReadMessage(req.Uid, req.MsgId, req.Limit, &msgs)
+	err := s.Dao.GetUndeliveredMessage(req.Uid, req.MsgId, req.Limit, &msgs)
 	if err != nil {
 		return nil, err
 	}
 	pullMsgs := make([]*pb.PullMsg, 0)
 	for _, msg := range msgs {
-		var pm pb.PullMsg
-		pm.MsgId = msg.MsgID
-		pm.Seq = msg.MsgSeq
-		pm.Ts = time.Now().UnixNano() / 1e6
-		pm.From = msg.MsgFrom
-		if msg.MsgType == C2GMessage {
-			pm.Group = msg.MsgTo
-		}
-		pm.Content = msg.MsgContent
-		pm.SendTime = msg.SendTime
-		pullMsgs = append(pullMsgs, &pm)
-		if err := s.Dao.SetMsgRead(msg.MsgID); err != nil {
+		pullMsgs = append(pullMsgs, toPullMsg(msg))
+		if err := s.Dao.SetMsgDelivered(msg.MsgID, req.Uid); err != nil {
 			log.Error(err)
 		}
+		s.Delivery.Ack(msg.MsgID, req.Uid)
+	}
+	rsp.Msg = pullMsgs
+	return rsp, nil
+}
+
+// PullBySeq returns the messages of a conversation of the user in a range of
+// conversation sequence numbers, clients use it to fill gaps.
+func (s *Service) PullBySeq(ctx context.Context, req *pb.PullBySeqRequest) (*pb.C2SPullMessageResponse, error) {
+	rsp := &pb.C2SPullMessageResponse{
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: req.Seq,
+	}
+	convID, err := s.conversationOf(req.Uid, req.Peer, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*model.ImMessageSend, 0)
+	if err := s.Dao.GetMessagesBySeq(convID, req.FromSeq, req.ToSeq, req.Limit, &msgs); err != nil {
+		return nil, err
+	}
+	pullMsgs := make([]*pb.PullMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		pullMsgs = append(pullMsgs, toPullMsg(msg))
 	}
 	rsp.Msg = pullMsgs
 	return rsp, nil
@@ -211,22 +303,24 @@ func (s *Service) C2SPull(ctx context.Context, req *pb.C2SPullMessageRequest) (*
 
 func (s *Service) C2CPushAck(ctx context.Context, response *pb.C2CPushResponse) (*pb.Response, error) {
 	rsp := &pb.Response{
-		Ts:                   time.Now().UnixNano()/1e6,
-		Seq:                  response.Seq,
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: response.Seq,
 	}
-	if err := s.Dao.SetMsgRead(response.MsgId); err != nil {
+	if err := s.Dao.SetMsgDelivered(response.MsgId, response.Uid); err != nil {
 		return nil, err
 	}
+	s.Delivery.Ack(response.MsgId, response.Uid)
 	return rsp, nil
 }
 
 func (s *Service) C2GPushAck(ctx context.Context, response *pb.C2GPushResponse) (*pb.Response, error) {
 	rsp := &pb.Response{
-		Ts:                   time.Now().UnixNano()/1e6,
-		Seq:                  response.Seq,
+		Ts:  time.Now().UnixNano() / 1e6,
+		Seq: response.Seq,
 	}
-	if err := s.Dao.SetMsgRead(response.MsgId); err != nil {
+	if err := s.Dao.SetMsgDelivered(response.MsgId, response.Uid); err != nil {
 		return nil, err
 	}
+	s.Delivery.Ack(response.MsgId, response.Uid)
 	return rsp, nil
 }
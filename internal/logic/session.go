@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/RainJoe/mim/internal/logic/config"
+	"github.com/RainJoe/mim/internal/logic/model"
+	"github.com/RainJoe/mim/internal/registry"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// kickOut disconnects the device of the session from its gate and removes the
+// session.
+func kickOut(s *Service, uid string, session *model.Session, reason int32) {
+	c, err := s.Push.Get(session.Gate)
+	if err != nil {
+		log.Error(err)
+	} else {
+		kickOutReq := &pbpush.KickOutRequest{
+			Uid:      uid,
+			DeviceId: session.DeviceID,
+			Reason:   reason,
+			Ts:       time.Now().UnixNano() / 1e6,
+		}
+		_, err = c.KickOut(context.TODO(), kickOutReq)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+	if err := s.Dao.DeleteUserSession(uid, session.DeviceID); err != nil {
+		log.Error(err)
+	}
+	before, after, err := s.Dao.RemovePresence(uid, session.DeviceID, session.Gate)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	s.presenceChanged(uid, before, after)
+}
+
+// gatesOf returns the distinct gates the devices of the user are connected
+// to. Sessions on gates that left the registry are removed, their gates
+// stopped refreshing their registration and are considered dead.
+func (s *Service) gatesOf(uid string) []string {
+	gates := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, session := range s.Dao.GetUserSessions(uid) {
+		if seen[session.Gate] {
+			continue
+		}
+		if _, err := s.Gates.Lookup(session.Gate); err == registry.ErrNotFound {
+			if err := s.Dao.DeleteUserSession(uid, session.DeviceID); err != nil {
+				log.Error(err)
+			}
+			continue
+		}
+		seen[session.Gate] = true
+		gates = append(gates, session.Gate)
+	}
+	return gates
+}
+
+// sessionsToKick returns the sessions of the user that have to be kicked out
+// before the new session is added, according to the device policy.
+//
+// A device logging in again replaces its old session. When the old session
+// is on the same gate the gate replaces the connection itself, kicking it out
+// here could close the new connection instead.
+func (s *Service) sessionsToKick(uid string, session *model.Session) []*model.Session {
+	conf := s.Conf.LogicServer
+	kicks := make([]*model.Session, 0)
+	others := make([]*model.Session, 0)
+	for _, old := range s.Dao.GetUserSessions(uid) {
+		if old.DeviceID == session.DeviceID {
+			if old.Gate != session.Gate {
+				kicks = append(kicks, old)
+			}
+			continue
+		}
+		if conf.DevicePolicy == config.DevicePolicyPlatform && old.Platform == session.Platform {
+			kicks = append(kicks, old)
+			continue
+		}
+		others = append(others, old)
+	}
+	if conf.DevicePolicy == config.DevicePolicyOldest && conf.MaxDevices > 0 && len(others) >= conf.MaxDevices {
+		sort.Slice(others, func(i, j int) bool {
+			return others[i].LoginTime < others[j].LoginTime
+		})
+		kicks = append(kicks, others[:len(others)-conf.MaxDevices+1]...)
+	}
+	return kicks
+}
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/RainJoe/mim/pb/logic"
+	pbpush "github.com/RainJoe/mim/pb/push"
+	log "github.com/RainJoe/mim/pkg/zaplog"
+)
+
+// maxSignalData is the size in bytes of the data of a custom signal
+const maxSignalData = 1024
+
+// Signal pushes an ephemeral signal such as typing to the peer or the online
+// members of the group. Nothing is stored, recipients that are offline or
+// miss the push never see the signal.
+func (s *Service) Signal(ctx context.Context, req *pb.SignalRequest) (*pb.SignalResponse, error) {
+	rsp := &pb.SignalResponse{
+		Status: int32(pb.SignalResponse_SUCCESS),
+		Msg:    "Success",
+		Ts:     time.Now().UnixNano() / 1e6,
+		Seq:    req.Seq,
+	}
+	fail := func(status pb.SignalResponse_Status) (*pb.SignalResponse, error) {
+		rsp.Status = int32(status)
+		rsp.Msg = status.String()
+		return rsp, nil
+	}
+	if (req.To == "") == (req.Group == "") || req.To == req.From || len(req.Data) > maxSignalData {
+		return fail(pb.SignalResponse_INVALID_ARGUMENT)
+	}
+	if rate := s.Conf.LogicServer.SignalRate; rate > 0 {
+		n, err := s.Dao.CountSignal(req.From)
+		if err != nil {
+			return nil, err
+		}
+		if n > int64(rate) {
+			return fail(pb.SignalResponse_RATE_LIMITED)
+		}
+	}
+	recipients := []string{req.To}
+	if req.Group != "" {
+		status, err := s.canSendToGroup(req.From, req.Group)
+		if err != nil {
+			return nil, err
+		}
+		switch status {
+		case pb.C2GSendResponse_NOT_MEMBER:
+			return fail(pb.SignalResponse_NOT_MEMBER)
+		case pb.C2GSendResponse_MUTED:
+			return fail(pb.SignalResponse_MUTED)
+		}
+		recipients = make([]string, 0)
+		for _, uid := range s.Dao.GetUserFromGroup(req.Group) {
+			if uid != req.From && s.Dao.IsUserOnline(uid) {
+				recipients = append(recipients, uid)
+			}
+		}
+	}
+	notice := &pbpush.SignalNotice{
+		From:  req.From,
+		To:    req.To,
+		Group: req.Group,
+		Type:  pbpush.SignalType(req.Type),
+		Data:  req.Data,
+		Ts:    time.Now().UnixNano() / 1e6,
+	}
+	go s.signalPush(notice, recipients)
+	return rsp, nil
+}
+
+func (s *Service) signalPush(notice *pbpush.SignalNotice, recipients []string) {
+	err := s.fanOut(recipients, func(c pbpush.PushServiceClient, uids []string) error {
+		req := &pbpush.SignalPushRequest{
+			To:     uids,
+			Notice: notice,
+			Ts:     time.Now().UnixNano() / 1e6,
+		}
+		_, err := c.Signal(context.TODO(), req)
+		return err
+	})
+	if err != nil && err != errUserOffline {
+		log.Error(err)
+	}
+}
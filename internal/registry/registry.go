@@ -0,0 +1,231 @@
+// Package registry keeps the endpoints of the running instances of a service
+// in redis. Every instance refreshes its entry with a heartbeat, the entry of
+// an instance that stops refreshing expires after the TTL and the instance
+// disappears from the registry.
+package registry
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/RainJoe/mim/pkg/zaplog"
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrNotFound is returned for instances that are not registered or whose
+// entry expired
+var ErrNotFound = errors.New("instance not registered")
+
+// Registry of the instances of one service. The endpoint of an instance is
+// stored in registry:<service>:<id> with the TTL, the ids of the service are
+// kept in the set registry:<service> to list them.
+type Registry struct {
+	pool    *redis.Pool
+	service string
+	ttl     time.Duration
+}
+
+// New creates the registry of service, entries expire after ttl
+func New(pool *redis.Pool, service string, ttl time.Duration) *Registry {
+	return &Registry{pool: pool, service: service, ttl: ttl}
+}
+
+func (r *Registry) key(id string) string {
+	return "registry:" + r.service + ":" + id
+}
+
+func (r *Registry) setKey() string {
+	return "registry:" + r.service
+}
+
+// Register adds the instance or refreshes its entry
+func (r *Registry) Register(id string, addr string) error {
+	conn := r.pool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	conn.Send("SET", r.key(id), addr, "PX", int64(r.ttl/time.Millisecond))
+	conn.Send("SADD", r.setKey(), id)
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// Deregister removes the instance
+func (r *Registry) Deregister(id string) error {
+	conn := r.pool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	conn.Send("DEL", r.key(id))
+	conn.Send("SREM", r.setKey(), id)
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// Lookup returns the endpoint of the instance
+func (r *Registry) Lookup(id string) (string, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+	addr, err := redis.String(conn.Do("GET", r.key(id)))
+	if err == redis.ErrNil {
+		return "", ErrNotFound
+	}
+	return addr, err
+}
+
+// Instances returns the endpoints of the live instances keyed by id, ids
+// whose entry expired are removed from the set
+func (r *Registry) Instances() (map[string]string, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+	ids, err := redis.Strings(conn.Do("SMEMBERS", r.setKey()))
+	if err != nil {
+		return nil, err
+	}
+	instances := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return instances, nil
+	}
+	keys := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, r.key(id))
+	}
+	addrs, err := redis.Values(conn.Do("MGET", keys...))
+	if err != nil {
+		return nil, err
+	}
+	dead := []interface{}{r.setKey()}
+	for i, id := range ids {
+		addr, err := redis.String(addrs[i], nil)
+		if err != nil {
+			dead = append(dead, id)
+			continue
+		}
+		instances[id] = addr
+	}
+	if len(dead) > 1 {
+		if _, err := conn.Do("SREM", dead...); err != nil {
+			log.Error(err)
+		}
+	}
+	return instances, nil
+}
+
+// Lease keeps an instance registered until it is stopped
+type Lease struct {
+	r    *Registry
+	id   string
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Keepalive registers the instance and refreshes its entry every third of
+// the TTL, registration errors are logged and retried on the next refresh.
+func (r *Registry) Keepalive(id string, addr string) *Lease {
+	l := &Lease{r: r, id: id, stop: make(chan struct{}), done: make(chan struct{})}
+	if err := r.Register(id, addr); err != nil {
+		log.Error(err)
+	}
+	go func() {
+		defer close(l.done)
+		ticker := time.NewTicker(r.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Register(id, addr); err != nil {
+					log.Error(err)
+				}
+			case <-l.stop:
+				return
+			}
+		}
+	}()
+	return l
+}
+
+// Stop stops refreshing and deregisters the instance
+func (l *Lease) Stop() {
+	close(l.stop)
+	<-l.done
+	if err := l.r.Deregister(l.id); err != nil {
+		log.Error(err)
+	}
+}
+
+// Watcher keeps a local copy of the instances of a registry that is reloaded
+// every interval, so lookups do not go to redis.
+type Watcher struct {
+	r *Registry
+
+	mu        sync.RWMutex
+	instances map[string]string
+	done      chan struct{}
+}
+
+// Watch loads the instances and starts reloading them every interval
+func (r *Registry) Watch(interval time.Duration) *Watcher {
+	w := &Watcher{r: r, instances: make(map[string]string), done: make(chan struct{})}
+	w.reload()
+	go w.loop(interval)
+	return w
+}
+
+func (w *Watcher) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.reload()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *Watcher) reload() {
+	instances, err := w.r.Instances()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	w.mu.Lock()
+	w.instances = instances
+	w.mu.Unlock()
+}
+
+// Lookup returns the endpoint of the instance. Instances missing from the
+// local copy are looked up in redis, they may have registered after the
+// last reload.
+func (w *Watcher) Lookup(id string) (string, error) {
+	w.mu.RLock()
+	addr, ok := w.instances[id]
+	w.mu.RUnlock()
+	if ok {
+		return addr, nil
+	}
+	addr, err := w.r.Lookup(id)
+	if err != nil {
+		return "", err
+	}
+	w.mu.Lock()
+	w.instances[id] = addr
+	w.mu.Unlock()
+	return addr, nil
+}
+
+// Instances returns a copy of the live instances keyed by id
+func (w *Watcher) Instances() map[string]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	instances := make(map[string]string, len(w.instances))
+	for id, addr := range w.instances {
+		instances[id] = addr
+	}
+	return instances
+}
+
+// Close stops reloading the instances
+func (w *Watcher) Close() {
+	close(w.done)
+}
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: blob.proto
+
+package blob
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Status int32
+
+const (
+	Status_SUCCESS        Status = 0
+	Status_NOT_FOUND      Status = 1
+	Status_TOO_LARGE      Status = 2
+	Status_QUOTA_EXCEEDED Status = 3
+	Status_BAD_CHECKSUM   Status = 4
+	Status_BAD_OFFSET     Status = 5
+	Status_INCOMPLETE     Status = 6
+	Status_DISABLED       Status = 7
+)
+
+var Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "NOT_FOUND",
+	2: "TOO_LARGE",
+	3: "QUOTA_EXCEEDED",
+	4: "BAD_CHECKSUM",
+	5: "BAD_OFFSET",
+	6: "INCOMPLETE",
+	7: "DISABLED",
+}
+
+var Status_value = map[string]int32{
+	"SUCCESS":        0,
+	"NOT_FOUND":      1,
+	"TOO_LARGE":      2,
+	"QUOTA_EXCEEDED": 3,
+	"BAD_CHECKSUM":   4,
+	"BAD_OFFSET":     5,
+	"INCOMPLETE":     6,
+	"DISABLED":       7,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{0}
+}
+
+// 开始上传，checksum对应的文件已经存在时直接返回blob_id，不需要再上传
+type UploadBeginRequest struct {
+	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Checksum             string   `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadBeginRequest) Reset()         { *m = UploadBeginRequest{} }
+func (m *UploadBeginRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadBeginRequest) ProtoMessage()    {}
+func (*UploadBeginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{0}
+}
+
+func (m *UploadBeginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadBeginRequest.Unmarshal(m, b)
+}
+func (m *UploadBeginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadBeginRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadBeginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadBeginRequest.Merge(m, src)
+}
+func (m *UploadBeginRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadBeginRequest.Size(m)
+}
+func (m *UploadBeginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadBeginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadBeginRequest proto.InternalMessageInfo
+
+func (m *UploadBeginRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *UploadBeginRequest) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *UploadBeginRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *UploadBeginRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type UploadBeginResponse struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=blob.Status" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	UploadId             string   `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ChunkSize            int32    `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	BlobId               string   `protobuf:"bytes,5,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Seq                  int64    `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadBeginResponse) Reset()         { *m = UploadBeginResponse{} }
+func (m *UploadBeginResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadBeginResponse) ProtoMessage()    {}
+func (*UploadBeginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{1}
+}
+
+func (m *UploadBeginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadBeginResponse.Unmarshal(m, b)
+}
+func (m *UploadBeginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadBeginResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadBeginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadBeginResponse.Merge(m, src)
+}
+func (m *UploadBeginResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadBeginResponse.Size(m)
+}
+func (m *UploadBeginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadBeginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadBeginResponse proto.InternalMessageInfo
+
+func (m *UploadBeginResponse) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_SUCCESS
+}
+
+func (m *UploadBeginResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *UploadBeginResponse) GetUploadId() string {
+	if m != nil {
+		return m.UploadId
+	}
+	return ""
+}
+
+func (m *UploadBeginResponse) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *UploadBeginResponse) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+func (m *UploadBeginResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 按顺序上传分片，断线后从应答中的received继续上传
+type UploadChunkRequest struct {
+	UploadId             string   `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadChunkRequest) Reset()         { *m = UploadChunkRequest{} }
+func (m *UploadChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadChunkRequest) ProtoMessage()    {}
+func (*UploadChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{2}
+}
+
+func (m *UploadChunkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadChunkRequest.Unmarshal(m, b)
+}
+func (m *UploadChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadChunkRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunkRequest.Merge(m, src)
+}
+func (m *UploadChunkRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadChunkRequest.Size(m)
+}
+func (m *UploadChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunkRequest proto.InternalMessageInfo
+
+func (m *UploadChunkRequest) GetUploadId() string {
+	if m != nil {
+		return m.UploadId
+	}
+	return ""
+}
+
+func (m *UploadChunkRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UploadChunkRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UploadChunkRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type UploadChunkResponse struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=blob.Status" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Received             int64    `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadChunkResponse) Reset()         { *m = UploadChunkResponse{} }
+func (m *UploadChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadChunkResponse) ProtoMessage()    {}
+func (*UploadChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{3}
+}
+
+func (m *UploadChunkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadChunkResponse.Unmarshal(m, b)
+}
+func (m *UploadChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadChunkResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunkResponse.Merge(m, src)
+}
+func (m *UploadChunkResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadChunkResponse.Size(m)
+}
+func (m *UploadChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunkResponse proto.InternalMessageInfo
+
+func (m *UploadChunkResponse) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_SUCCESS
+}
+
+func (m *UploadChunkResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *UploadChunkResponse) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *UploadChunkResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type UploadCommitRequest struct {
+	UploadId             string   `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Checksum             string   `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadCommitRequest) Reset()         { *m = UploadCommitRequest{} }
+func (m *UploadCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadCommitRequest) ProtoMessage()    {}
+func (*UploadCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{4}
+}
+
+func (m *UploadCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadCommitRequest.Unmarshal(m, b)
+}
+func (m *UploadCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadCommitRequest.Merge(m, src)
+}
+func (m *UploadCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadCommitRequest.Size(m)
+}
+func (m *UploadCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadCommitRequest proto.InternalMessageInfo
+
+func (m *UploadCommitRequest) GetUploadId() string {
+	if m != nil {
+		return m.UploadId
+	}
+	return ""
+}
+
+func (m *UploadCommitRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *UploadCommitRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type UploadCommitResponse struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=blob.Status" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	BlobId               string   `protobuf:"bytes,3,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadCommitResponse) Reset()         { *m = UploadCommitResponse{} }
+func (m *UploadCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadCommitResponse) ProtoMessage()    {}
+func (*UploadCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{5}
+}
+
+func (m *UploadCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadCommitResponse.Unmarshal(m, b)
+}
+func (m *UploadCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadCommitResponse.Merge(m, src)
+}
+func (m *UploadCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadCommitResponse.Size(m)
+}
+func (m *UploadCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadCommitResponse proto.InternalMessageInfo
+
+func (m *UploadCommitResponse) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_SUCCESS
+}
+
+func (m *UploadCommitResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *UploadCommitResponse) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+func (m *UploadCommitResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *UploadCommitResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 按分片下载文件
+type DownloadRequest struct {
+	BlobId               string   `protobuf:"bytes,1,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int32    `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadRequest) Reset()         { *m = DownloadRequest{} }
+func (m *DownloadRequest) String() string { return proto.CompactTextString(m) }
+func (*DownloadRequest) ProtoMessage()    {}
+func (*DownloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{6}
+}
+
+func (m *DownloadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadRequest.Unmarshal(m, b)
+}
+func (m *DownloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadRequest.Marshal(b, m, deterministic)
+}
+func (m *DownloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadRequest.Merge(m, src)
+}
+func (m *DownloadRequest) XXX_Size() int {
+	return xxx_messageInfo_DownloadRequest.Size(m)
+}
+func (m *DownloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadRequest proto.InternalMessageInfo
+
+func (m *DownloadRequest) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+func (m *DownloadRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DownloadRequest) GetLength() int32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *DownloadRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type DownloadResponse struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=blob.Status" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	BlobId               string   `protobuf:"bytes,3,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Size                 int64    `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,7,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Seq                  int64    `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadResponse) Reset()         { *m = DownloadResponse{} }
+func (m *DownloadResponse) String() string { return proto.CompactTextString(m) }
+func (*DownloadResponse) ProtoMessage()    {}
+func (*DownloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6903d1e8a20272e8, []int{7}
+}
+
+func (m *DownloadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadResponse.Unmarshal(m, b)
+}
+func (m *DownloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadResponse.Marshal(b, m, deterministic)
+}
+func (m *DownloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadResponse.Merge(m, src)
+}
+func (m *DownloadResponse) XXX_Size() int {
+	return xxx_messageInfo_DownloadResponse.Size(m)
+}
+func (m *DownloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadResponse proto.InternalMessageInfo
+
+func (m *DownloadResponse) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_SUCCESS
+}
+
+func (m *DownloadResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *DownloadResponse) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+func (m *DownloadResponse) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DownloadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DownloadResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *DownloadResponse) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *DownloadResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("blob.Status", Status_name, Status_value)
+	proto.RegisterType((*UploadBeginRequest)(nil), "blob.UploadBeginRequest")
+	proto.RegisterType((*UploadBeginResponse)(nil), "blob.UploadBeginResponse")
+	proto.RegisterType((*UploadChunkRequest)(nil), "blob.UploadChunkRequest")
+	proto.RegisterType((*UploadChunkResponse)(nil), "blob.UploadChunkResponse")
+	proto.RegisterType((*UploadCommitRequest)(nil), "blob.UploadCommitRequest")
+	proto.RegisterType((*UploadCommitResponse)(nil), "blob.UploadCommitResponse")
+	proto.RegisterType((*DownloadRequest)(nil), "blob.DownloadRequest")
+	proto.RegisterType((*DownloadResponse)(nil), "blob.DownloadResponse")
+}
+
+func init() { proto.RegisterFile("blob.proto", fileDescriptor_6903d1e8a20272e8) }
+
+var fileDescriptor_6903d1e8a20272e8 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0xcb, 0x4f, 0xdb, 0x43, 0x29, 0x96, 0x87, 0x46, 0x35, 0x84, 0x34, 0x4d, 0x5c, 0x4c,
+	0x5c, 0xec, 0x02, 0x9e, 0xa0, 0x4d, 0x5c, 0xa8, 0xe8, 0x1a, 0x70, 0x52, 0x89, 0xbb, 0xd0, 0x1f,
+	0xaf, 0x8d, 0xd6, 0xfc, 0x6c, 0x4e, 0x40, 0x83, 0x07, 0xe0, 0x8a, 0xb7, 0xe1, 0x49, 0x78, 0x22,
+	0x64, 0x37, 0x69, 0x92, 0x29, 0x93, 0x90, 0x26, 0xee, 0xce, 0x77, 0x22, 0x9f, 0xef, 0xc7, 0xc7,
+	0x01, 0x58, 0x6c, 0xe3, 0xc5, 0x79, 0x72, 0x13, 0xa7, 0x31, 0xd1, 0x65, 0x7d, 0x2a, 0x80, 0xcc,
+	0x92, 0x6d, 0x3c, 0x5f, 0x0d, 0xf9, 0x3a, 0x88, 0x18, 0xbf, 0xce, 0xb8, 0x48, 0x09, 0x01, 0x5d,
+	0x04, 0xdf, 0x79, 0x1f, 0x9d, 0xa0, 0x33, 0x8d, 0xa9, 0x9a, 0xbc, 0x80, 0x4e, 0x18, 0x84, 0xdc,
+	0x4f, 0x6f, 0x13, 0xde, 0x3f, 0x38, 0x41, 0x67, 0x1d, 0xd6, 0x96, 0x0d, 0xef, 0x36, 0xe1, 0xe4,
+	0x18, 0xda, 0xcb, 0x0d, 0x5f, 0x5e, 0x89, 0x2c, 0xec, 0x6b, 0xbb, 0x6f, 0x05, 0x26, 0x18, 0x34,
+	0xc1, 0xaf, 0xfb, 0xba, 0x9a, 0x25, 0xcb, 0xd3, 0xdf, 0x08, 0x0e, 0x6b, 0xac, 0x22, 0x89, 0x23,
+	0xc1, 0xc9, 0x2b, 0x30, 0x45, 0x3a, 0x4f, 0x33, 0xa1, 0x88, 0x7b, 0x6f, 0xba, 0xe7, 0x4a, 0xaf,
+	0xab, 0x7a, 0x2c, 0xff, 0x26, 0xe7, 0x85, 0x62, 0x9d, 0x4b, 0x90, 0xa5, 0x94, 0x96, 0xa9, 0x71,
+	0x7e, 0xb0, 0x2a, 0xe8, 0x77, 0x8d, 0xf1, 0x8a, 0xbc, 0x04, 0x58, 0x6e, 0xb2, 0xe8, 0xca, 0x57,
+	0x8e, 0xa4, 0x0a, 0x83, 0x75, 0x54, 0xc7, 0x95, 0xb6, 0x9e, 0x43, 0x4b, 0x92, 0xc8, 0x93, 0x86,
+	0x3a, 0x69, 0x4a, 0x38, 0x5e, 0x15, 0xb2, 0xcd, 0x52, 0x76, 0x5c, 0x64, 0x65, 0xc9, 0xd3, 0x45,
+	0x56, 0x35, 0x72, 0x74, 0x87, 0xfc, 0x08, 0xcc, 0xf8, 0xf2, 0x52, 0xf0, 0x54, 0xc9, 0xd5, 0x58,
+	0x8e, 0x64, 0xc0, 0xab, 0x79, 0x3a, 0x57, 0x62, 0xbb, 0x4c, 0xd5, 0x0d, 0x39, 0xfd, 0x80, 0xc3,
+	0x1a, 0xe1, 0x03, 0x63, 0x3a, 0x86, 0xf6, 0x0d, 0x5f, 0xf2, 0xe0, 0x2b, 0xdf, 0xa5, 0xa4, 0xb1,
+	0x3d, 0x6e, 0x20, 0xff, 0xb2, 0x27, 0x8f, 0xc3, 0x30, 0x48, 0xff, 0xc9, 0x6e, 0x75, 0x0d, 0x0e,
+	0x9a, 0xd7, 0x40, 0x2b, 0x19, 0x7e, 0x21, 0x78, 0x56, 0xa7, 0x78, 0xa0, 0xc1, 0xca, 0x5d, 0x6a,
+	0xb5, 0xbb, 0x2c, 0xf6, 0x59, 0xaf, 0xec, 0x73, 0xae, 0xc7, 0x28, 0xf5, 0x6c, 0xe1, 0xa9, 0x1d,
+	0x7f, 0x8b, 0xa4, 0xa0, 0xc2, 0x6d, 0x65, 0x22, 0xaa, 0x4d, 0xbc, 0xef, 0x62, 0x8f, 0xc0, 0xdc,
+	0xf2, 0x68, 0x9d, 0x6e, 0x94, 0x02, 0x83, 0xe5, 0xa8, 0x21, 0xdf, 0x3f, 0x08, 0x70, 0x49, 0xf7,
+	0xbf, 0x9c, 0x97, 0x3a, 0xf5, 0xc6, 0x05, 0x34, 0x2a, 0x0b, 0x58, 0xa4, 0x64, 0xde, 0xf7, 0xea,
+	0x5b, 0x77, 0x5e, 0x7d, 0x6e, 0xaa, 0xbd, 0x37, 0xf5, 0xfa, 0x27, 0x02, 0x73, 0x27, 0x96, 0x3c,
+	0x86, 0x96, 0x3b, 0xb3, 0x2c, 0xea, 0xba, 0xf8, 0x11, 0x79, 0x02, 0x9d, 0xa9, 0xe3, 0xf9, 0x23,
+	0x67, 0x36, 0xb5, 0x31, 0x92, 0xd0, 0x73, 0x1c, 0x7f, 0x32, 0x60, 0xef, 0x28, 0x3e, 0x20, 0x04,
+	0x7a, 0x9f, 0x66, 0x8e, 0x37, 0xf0, 0xe9, 0x67, 0x8b, 0x52, 0x9b, 0xda, 0x58, 0x23, 0x18, 0xba,
+	0xc3, 0x81, 0xed, 0x5b, 0xef, 0xa9, 0xf5, 0xc1, 0x9d, 0x5d, 0x60, 0x9d, 0xf4, 0x00, 0x64, 0xc7,
+	0x19, 0x8d, 0x5c, 0xea, 0x61, 0x43, 0xe2, 0xf1, 0xd4, 0x72, 0x2e, 0x3e, 0x4e, 0xa8, 0x47, 0xb1,
+	0x49, 0xba, 0xd0, 0xb6, 0xc7, 0xee, 0x60, 0x38, 0xa1, 0x36, 0x6e, 0x2d, 0x4c, 0xf5, 0x97, 0x7b,
+	0xfb, 0x77, 0x00, 0xbe, 0xa2, 0xc9, 0x7f, 0xf3, 0x04, 0x00, 0x00,
+}
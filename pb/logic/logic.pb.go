@@ -24,6 +24,378 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// 消息内容的类型，和Payload中设置的字段一致
+type ContentType int32
+
+const (
+	ContentType_TEXT     ContentType = 0
+	ContentType_IMAGE    ContentType = 1
+	ContentType_FILE     ContentType = 2
+	ContentType_LOCATION ContentType = 3
+	ContentType_CUSTOM   ContentType = 4
+)
+
+var ContentType_name = map[int32]string{
+	0: "TEXT",
+	1: "IMAGE",
+	2: "FILE",
+	3: "LOCATION",
+	4: "CUSTOM",
+}
+
+var ContentType_value = map[string]int32{
+	"TEXT":     0,
+	"IMAGE":    1,
+	"FILE":     2,
+	"LOCATION": 3,
+	"CUSTOM":   4,
+}
+
+func (x ContentType) String() string {
+	return proto.EnumName(ContentType_name, int32(x))
+}
+
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{0}
+}
+
+// 群成员角色，群主可以解散群、设置管理员和转让群，管理员可以改名、禁言和移除普通成员
+type GroupRole int32
+
+const (
+	GroupRole_MEMBER GroupRole = 0
+	GroupRole_ADMIN  GroupRole = 1
+	GroupRole_OWNER  GroupRole = 2
+)
+
+var GroupRole_name = map[int32]string{
+	0: "MEMBER",
+	1: "ADMIN",
+	2: "OWNER",
+}
+
+var GroupRole_value = map[string]int32{
+	"MEMBER": 0,
+	"ADMIN":  1,
+	"OWNER":  2,
+}
+
+func (x GroupRole) String() string {
+	return proto.EnumName(GroupRole_name, int32(x))
+}
+
+func (GroupRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{1}
+}
+
+// 用户的在线状态，一个用户有设备在线即为在线，所有在线设备都离开时为离开
+type PresenceState int32
+
+const (
+	PresenceState_OFFLINE PresenceState = 0
+	PresenceState_ONLINE  PresenceState = 1
+	PresenceState_AWAY    PresenceState = 2
+)
+
+var PresenceState_name = map[int32]string{
+	0: "OFFLINE",
+	1: "ONLINE",
+	2: "AWAY",
+}
+
+var PresenceState_value = map[string]int32{
+	"OFFLINE": 0,
+	"ONLINE":  1,
+	"AWAY":    2,
+}
+
+func (x PresenceState) String() string {
+	return proto.EnumName(PresenceState_name, int32(x))
+}
+
+func (PresenceState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{2}
+}
+
+type AuthResponse_Status int32
+
+const (
+	AuthResponse_SUCCESS       AuthResponse_Status = 0
+	AuthResponse_USER_INVALID  AuthResponse_Status = 1
+	AuthResponse_TOKEN_EXPIRED AuthResponse_Status = 2
+	AuthResponse_BAD_SIGNATURE AuthResponse_Status = 3
+	AuthResponse_TOKEN_INVALID AuthResponse_Status = 4
+)
+
+var AuthResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "USER_INVALID",
+	2: "TOKEN_EXPIRED",
+	3: "BAD_SIGNATURE",
+	4: "TOKEN_INVALID",
+}
+
+var AuthResponse_Status_value = map[string]int32{
+	"SUCCESS":       0,
+	"USER_INVALID":  1,
+	"TOKEN_EXPIRED": 2,
+	"BAD_SIGNATURE": 3,
+	"TOKEN_INVALID": 4,
+}
+
+func (x AuthResponse_Status) String() string {
+	return proto.EnumName(AuthResponse_Status_name, int32(x))
+}
+
+func (AuthResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{8, 0}
+}
+
+type C2CSendResponse_Status int32
+
+const (
+	C2CSendResponse_SUCCESS         C2CSendResponse_Status = 0
+	C2CSendResponse_INVALID_PAYLOAD C2CSendResponse_Status = 1
+)
+
+var C2CSendResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "INVALID_PAYLOAD",
+}
+
+var C2CSendResponse_Status_value = map[string]int32{
+	"SUCCESS":         0,
+	"INVALID_PAYLOAD": 1,
+}
+
+func (x C2CSendResponse_Status) String() string {
+	return proto.EnumName(C2CSendResponse_Status_name, int32(x))
+}
+
+func (C2CSendResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{12, 0}
+}
+
+type C2GSendResponse_Status int32
+
+const (
+	C2GSendResponse_SUCCESS         C2GSendResponse_Status = 0
+	C2GSendResponse_NOT_MEMBER      C2GSendResponse_Status = 1
+	C2GSendResponse_MUTED           C2GSendResponse_Status = 2
+	C2GSendResponse_INVALID_PAYLOAD C2GSendResponse_Status = 3
+)
+
+var C2GSendResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "NOT_MEMBER",
+	2: "MUTED",
+	3: "INVALID_PAYLOAD",
+}
+
+var C2GSendResponse_Status_value = map[string]int32{
+	"SUCCESS":         0,
+	"NOT_MEMBER":      1,
+	"MUTED":           2,
+	"INVALID_PAYLOAD": 3,
+}
+
+func (x C2GSendResponse_Status) String() string {
+	return proto.EnumName(C2GSendResponse_Status_name, int32(x))
+}
+
+func (C2GSendResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{15, 0}
+}
+
+type GroupResponse_Status int32
+
+const (
+	GroupResponse_SUCCESS           GroupResponse_Status = 0
+	GroupResponse_GROUP_NOT_FOUND   GroupResponse_Status = 1
+	GroupResponse_NOT_MEMBER        GroupResponse_Status = 2
+	GroupResponse_ALREADY_MEMBER    GroupResponse_Status = 3
+	GroupResponse_USER_INVALID      GroupResponse_Status = 4
+	GroupResponse_INVALID_ARGUMENT  GroupResponse_Status = 5
+	GroupResponse_PERMISSION_DENIED GroupResponse_Status = 6
+)
+
+var GroupResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "GROUP_NOT_FOUND",
+	2: "NOT_MEMBER",
+	3: "ALREADY_MEMBER",
+	4: "USER_INVALID",
+	5: "INVALID_ARGUMENT",
+	6: "PERMISSION_DENIED",
+}
+
+var GroupResponse_Status_value = map[string]int32{
+	"SUCCESS":           0,
+	"GROUP_NOT_FOUND":   1,
+	"NOT_MEMBER":        2,
+	"ALREADY_MEMBER":    3,
+	"USER_INVALID":      4,
+	"INVALID_ARGUMENT":  5,
+	"PERMISSION_DENIED": 6,
+}
+
+func (x GroupResponse_Status) String() string {
+	return proto.EnumName(GroupResponse_Status_name, int32(x))
+}
+
+func (GroupResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{21, 0}
+}
+
+type RecallResponse_Status int32
+
+const (
+	RecallResponse_SUCCESS           RecallResponse_Status = 0
+	RecallResponse_NOT_FOUND         RecallResponse_Status = 1
+	RecallResponse_PERMISSION_DENIED RecallResponse_Status = 2
+	RecallResponse_EXPIRED           RecallResponse_Status = 3
+)
+
+var RecallResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "NOT_FOUND",
+	2: "PERMISSION_DENIED",
+	3: "EXPIRED",
+}
+
+var RecallResponse_Status_value = map[string]int32{
+	"SUCCESS":           0,
+	"NOT_FOUND":         1,
+	"PERMISSION_DENIED": 2,
+	"EXPIRED":           3,
+}
+
+func (x RecallResponse_Status) String() string {
+	return proto.EnumName(RecallResponse_Status_name, int32(x))
+}
+
+func (RecallResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{35, 0}
+}
+
+type HistoryRequest_Direction int32
+
+const (
+	HistoryRequest_BACKWARD HistoryRequest_Direction = 0
+	HistoryRequest_FORWARD  HistoryRequest_Direction = 1
+)
+
+var HistoryRequest_Direction_name = map[int32]string{
+	0: "BACKWARD",
+	1: "FORWARD",
+}
+
+var HistoryRequest_Direction_value = map[string]int32{
+	"BACKWARD": 0,
+	"FORWARD":  1,
+}
+
+func (x HistoryRequest_Direction) String() string {
+	return proto.EnumName(HistoryRequest_Direction_name, int32(x))
+}
+
+func (HistoryRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{46, 0}
+}
+
+type PresenceResponse_Status int32
+
+const (
+	PresenceResponse_SUCCESS                PresenceResponse_Status = 0
+	PresenceResponse_INVALID_ARGUMENT       PresenceResponse_Status = 1
+	PresenceResponse_TOO_MANY_SUBSCRIPTIONS PresenceResponse_Status = 2
+	PresenceResponse_NOT_RELATED            PresenceResponse_Status = 3
+)
+
+var PresenceResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "INVALID_ARGUMENT",
+	2: "TOO_MANY_SUBSCRIPTIONS",
+	3: "NOT_RELATED",
+}
+
+var PresenceResponse_Status_value = map[string]int32{
+	"SUCCESS":                0,
+	"INVALID_ARGUMENT":       1,
+	"TOO_MANY_SUBSCRIPTIONS": 2,
+	"NOT_RELATED":            3,
+}
+
+func (x PresenceResponse_Status) String() string {
+	return proto.EnumName(PresenceResponse_Status_name, int32(x))
+}
+
+func (PresenceResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{54, 0}
+}
+
+type SignalRequest_Type int32
+
+const (
+	SignalRequest_TYPING         SignalRequest_Type = 0
+	SignalRequest_TYPING_STOPPED SignalRequest_Type = 1
+	SignalRequest_CUSTOM         SignalRequest_Type = 2
+)
+
+var SignalRequest_Type_name = map[int32]string{
+	0: "TYPING",
+	1: "TYPING_STOPPED",
+	2: "CUSTOM",
+}
+
+var SignalRequest_Type_value = map[string]int32{
+	"TYPING":         0,
+	"TYPING_STOPPED": 1,
+	"CUSTOM":         2,
+}
+
+func (x SignalRequest_Type) String() string {
+	return proto.EnumName(SignalRequest_Type_name, int32(x))
+}
+
+func (SignalRequest_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{55, 0}
+}
+
+type SignalResponse_Status int32
+
+const (
+	SignalResponse_SUCCESS          SignalResponse_Status = 0
+	SignalResponse_INVALID_ARGUMENT SignalResponse_Status = 1
+	SignalResponse_NOT_MEMBER       SignalResponse_Status = 2
+	SignalResponse_MUTED            SignalResponse_Status = 3
+	SignalResponse_RATE_LIMITED     SignalResponse_Status = 4
+)
+
+var SignalResponse_Status_name = map[int32]string{
+	0: "SUCCESS",
+	1: "INVALID_ARGUMENT",
+	2: "NOT_MEMBER",
+	3: "MUTED",
+	4: "RATE_LIMITED",
+}
+
+var SignalResponse_Status_value = map[string]int32{
+	"SUCCESS":          0,
+	"INVALID_ARGUMENT": 1,
+	"NOT_MEMBER":       2,
+	"MUTED":            3,
+	"RATE_LIMITED":     4,
+}
+
+func (x SignalResponse_Status) String() string {
+	return proto.EnumName(SignalResponse_Status_name, int32(x))
+}
+
+func (SignalResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{56, 0}
+}
+
 type Response struct {
 	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
 	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -71,903 +443,4305 @@ func (m *Response) GetSeq() int64 {
 	return 0
 }
 
-type AuthRequest struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// 结构化的消息内容
+type Payload struct {
+	// Types that are valid to be assigned to Body:
+	//	*Payload_Text
+	//	*Payload_Image
+	//	*Payload_File
+	//	*Payload_Location
+	//	*Payload_Custom
+	Body                 isPayload_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Payload) Reset()         { *m = Payload{} }
+func (m *Payload) String() string { return proto.CompactTextString(m) }
+func (*Payload) ProtoMessage()    {}
+func (*Payload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{1}
 }
 
-func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
-func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRequest) ProtoMessage()    {}
-func (*AuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{1}
+func (m *Payload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payload.Unmarshal(m, b)
+}
+func (m *Payload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payload.Marshal(b, m, deterministic)
+}
+func (m *Payload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payload.Merge(m, src)
+}
+func (m *Payload) XXX_Size() int {
+	return xxx_messageInfo_Payload.Size(m)
+}
+func (m *Payload) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payload.DiscardUnknown(m)
 }
 
-func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
+var xxx_messageInfo_Payload proto.InternalMessageInfo
+
+type isPayload_Body interface {
+	isPayload_Body()
 }
-func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+
+type Payload_Text struct {
+	Text *TextPayload `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
 }
-func (m *AuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRequest.Merge(m, src)
+
+type Payload_Image struct {
+	Image *ImagePayload `protobuf:"bytes,2,opt,name=image,proto3,oneof"`
 }
-func (m *AuthRequest) XXX_Size() int {
-	return xxx_messageInfo_AuthRequest.Size(m)
+
+type Payload_File struct {
+	File *FilePayload `protobuf:"bytes,3,opt,name=file,proto3,oneof"`
 }
-func (m *AuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+
+type Payload_Location struct {
+	Location *LocationPayload `protobuf:"bytes,4,opt,name=location,proto3,oneof"`
 }
 
-var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
+type Payload_Custom struct {
+	Custom *CustomPayload `protobuf:"bytes,5,opt,name=custom,proto3,oneof"`
+}
 
-func (m *AuthRequest) GetToken() string {
+func (*Payload_Text) isPayload_Body() {}
+
+func (*Payload_Image) isPayload_Body() {}
+
+func (*Payload_File) isPayload_Body() {}
+
+func (*Payload_Location) isPayload_Body() {}
+
+func (*Payload_Custom) isPayload_Body() {}
+
+func (m *Payload) GetBody() isPayload_Body {
 	if m != nil {
-		return m.Token
+		return m.Body
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthRequest) GetUid() string {
-	if m != nil {
-		return m.Uid
+func (m *Payload) GetText() *TextPayload {
+	if x, ok := m.GetBody().(*Payload_Text); ok {
+		return x.Text
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthRequest) GetTs() int64 {
-	if m != nil {
-		return m.Ts
+func (m *Payload) GetImage() *ImagePayload {
+	if x, ok := m.GetBody().(*Payload_Image); ok {
+		return x.Image
 	}
-	return 0
+	return nil
 }
 
-func (m *AuthRequest) GetSeq() int64 {
-	if m != nil {
-		return m.Seq
+func (m *Payload) GetFile() *FilePayload {
+	if x, ok := m.GetBody().(*Payload_File); ok {
+		return x.File
 	}
-	return 0
+	return nil
 }
 
-type AuthResponse struct {
-	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *Payload) GetLocation() *LocationPayload {
+	if x, ok := m.GetBody().(*Payload_Location); ok {
+		return x.Location
+	}
+	return nil
+}
+
+func (m *Payload) GetCustom() *CustomPayload {
+	if x, ok := m.GetBody().(*Payload_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Payload) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Payload_Text)(nil),
+		(*Payload_Image)(nil),
+		(*Payload_File)(nil),
+		(*Payload_Location)(nil),
+		(*Payload_Custom)(nil),
+	}
+}
+
+type TextPayload struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
-func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthResponse) ProtoMessage()    {}
-func (*AuthResponse) Descriptor() ([]byte, []int) {
+func (m *TextPayload) Reset()         { *m = TextPayload{} }
+func (m *TextPayload) String() string { return proto.CompactTextString(m) }
+func (*TextPayload) ProtoMessage()    {}
+func (*TextPayload) Descriptor() ([]byte, []int) {
 	return fileDescriptor_60207fea82c31ca8, []int{2}
 }
 
-func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
+func (m *TextPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextPayload.Unmarshal(m, b)
 }
-func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+func (m *TextPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextPayload.Marshal(b, m, deterministic)
 }
-func (m *AuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthResponse.Merge(m, src)
+func (m *TextPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextPayload.Merge(m, src)
 }
-func (m *AuthResponse) XXX_Size() int {
-	return xxx_messageInfo_AuthResponse.Size(m)
+func (m *TextPayload) XXX_Size() int {
+	return xxx_messageInfo_TextPayload.Size(m)
 }
-func (m *AuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+func (m *TextPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_TextPayload proto.InternalMessageInfo
 
-func (m *AuthResponse) GetStatus() int32 {
+func (m *TextPayload) GetText() string {
 	if m != nil {
-		return m.Status
+		return m.Text
 	}
-	return 0
+	return ""
 }
 
-func (m *AuthResponse) GetMsg() string {
+type ImagePayload struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl         string   `protobuf:"bytes,2,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Width                int32    `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height               int32    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Size                 int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	BlobId               string   `protobuf:"bytes,7,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePayload) Reset()         { *m = ImagePayload{} }
+func (m *ImagePayload) String() string { return proto.CompactTextString(m) }
+func (*ImagePayload) ProtoMessage()    {}
+func (*ImagePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{3}
+}
+
+func (m *ImagePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImagePayload.Unmarshal(m, b)
+}
+func (m *ImagePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImagePayload.Marshal(b, m, deterministic)
+}
+func (m *ImagePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePayload.Merge(m, src)
+}
+func (m *ImagePayload) XXX_Size() int {
+	return xxx_messageInfo_ImagePayload.Size(m)
+}
+func (m *ImagePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePayload proto.InternalMessageInfo
+
+func (m *ImagePayload) GetUrl() string {
 	if m != nil {
-		return m.Msg
+		return m.Url
 	}
 	return ""
 }
 
-func (m *AuthResponse) GetTs() int64 {
+func (m *ImagePayload) GetThumbnailUrl() string {
 	if m != nil {
-		return m.Ts
+		return m.ThumbnailUrl
+	}
+	return ""
+}
+
+func (m *ImagePayload) GetWidth() int32 {
+	if m != nil {
+		return m.Width
 	}
 	return 0
 }
 
-func (m *AuthResponse) GetSeq() int64 {
+func (m *ImagePayload) GetHeight() int32 {
 	if m != nil {
-		return m.Seq
+		return m.Height
 	}
 	return 0
 }
 
-type LogoutRequest struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *ImagePayload) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ImagePayload) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *ImagePayload) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+type FilePayload struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	BlobId               string   `protobuf:"bytes,5,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
-func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
-func (*LogoutRequest) ProtoMessage()    {}
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{3}
+func (m *FilePayload) Reset()         { *m = FilePayload{} }
+func (m *FilePayload) String() string { return proto.CompactTextString(m) }
+func (*FilePayload) ProtoMessage()    {}
+func (*FilePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{4}
 }
 
-func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+func (m *FilePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilePayload.Unmarshal(m, b)
 }
-func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+func (m *FilePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilePayload.Marshal(b, m, deterministic)
 }
-func (m *LogoutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogoutRequest.Merge(m, src)
+func (m *FilePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilePayload.Merge(m, src)
 }
-func (m *LogoutRequest) XXX_Size() int {
-	return xxx_messageInfo_LogoutRequest.Size(m)
+func (m *FilePayload) XXX_Size() int {
+	return xxx_messageInfo_FilePayload.Size(m)
 }
-func (m *LogoutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+func (m *FilePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilePayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+var xxx_messageInfo_FilePayload proto.InternalMessageInfo
 
-func (m *LogoutRequest) GetToken() string {
+func (m *FilePayload) GetUrl() string {
 	if m != nil {
-		return m.Token
+		return m.Url
 	}
 	return ""
 }
 
-func (m *LogoutRequest) GetUid() string {
+func (m *FilePayload) GetName() string {
 	if m != nil {
-		return m.Uid
+		return m.Name
 	}
 	return ""
 }
 
-func (m *LogoutRequest) GetTs() int64 {
+func (m *FilePayload) GetSize() int64 {
 	if m != nil {
-		return m.Ts
+		return m.Size
 	}
 	return 0
 }
 
-func (m *LogoutRequest) GetSeq() int64 {
+func (m *FilePayload) GetMimeType() string {
 	if m != nil {
-		return m.Seq
+		return m.MimeType
 	}
-	return 0
+	return ""
 }
 
-type LogoutResponse struct {
-	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *FilePayload) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+type LocationPayload struct {
+	Latitude             float64  `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            float64  `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
-func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
-func (*LogoutResponse) ProtoMessage()    {}
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{4}
+func (m *LocationPayload) Reset()         { *m = LocationPayload{} }
+func (m *LocationPayload) String() string { return proto.CompactTextString(m) }
+func (*LocationPayload) ProtoMessage()    {}
+func (*LocationPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{5}
 }
 
-func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
+func (m *LocationPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationPayload.Unmarshal(m, b)
 }
-func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
+func (m *LocationPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationPayload.Marshal(b, m, deterministic)
 }
-func (m *LogoutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogoutResponse.Merge(m, src)
+func (m *LocationPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationPayload.Merge(m, src)
 }
-func (m *LogoutResponse) XXX_Size() int {
-	return xxx_messageInfo_LogoutResponse.Size(m)
+func (m *LocationPayload) XXX_Size() int {
+	return xxx_messageInfo_LocationPayload.Size(m)
 }
-func (m *LogoutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
+func (m *LocationPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
+var xxx_messageInfo_LocationPayload proto.InternalMessageInfo
 
-func (m *LogoutResponse) GetTs() int64 {
+func (m *LocationPayload) GetLatitude() float64 {
 	if m != nil {
-		return m.Ts
+		return m.Latitude
 	}
 	return 0
 }
 
-func (m *LogoutResponse) GetSeq() int64 {
+func (m *LocationPayload) GetLongitude() float64 {
 	if m != nil {
-		return m.Seq
+		return m.Longitude
 	}
 	return 0
 }
 
-// 发送者发送消息的协议
-type C2CSendRequest struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Content              string   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *LocationPayload) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type CustomPayload struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2CSendRequest) Reset()         { *m = C2CSendRequest{} }
-func (m *C2CSendRequest) String() string { return proto.CompactTextString(m) }
-func (*C2CSendRequest) ProtoMessage()    {}
-func (*C2CSendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{5}
+func (m *CustomPayload) Reset()         { *m = CustomPayload{} }
+func (m *CustomPayload) String() string { return proto.CompactTextString(m) }
+func (*CustomPayload) ProtoMessage()    {}
+func (*CustomPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{6}
 }
 
-func (m *C2CSendRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2CSendRequest.Unmarshal(m, b)
+func (m *CustomPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomPayload.Unmarshal(m, b)
 }
-func (m *C2CSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2CSendRequest.Marshal(b, m, deterministic)
+func (m *CustomPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomPayload.Marshal(b, m, deterministic)
 }
-func (m *C2CSendRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2CSendRequest.Merge(m, src)
+func (m *CustomPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomPayload.Merge(m, src)
 }
-func (m *C2CSendRequest) XXX_Size() int {
-	return xxx_messageInfo_C2CSendRequest.Size(m)
+func (m *CustomPayload) XXX_Size() int {
+	return xxx_messageInfo_CustomPayload.Size(m)
 }
-func (m *C2CSendRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2CSendRequest.DiscardUnknown(m)
+func (m *CustomPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2CSendRequest proto.InternalMessageInfo
-
-func (m *C2CSendRequest) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
-}
+var xxx_messageInfo_CustomPayload proto.InternalMessageInfo
 
-func (m *C2CSendRequest) GetTo() string {
+func (m *CustomPayload) GetType() string {
 	if m != nil {
-		return m.To
+		return m.Type
 	}
 	return ""
 }
 
-func (m *C2CSendRequest) GetContent() string {
+func (m *CustomPayload) GetData() string {
 	if m != nil {
-		return m.Content
+		return m.Data
 	}
 	return ""
 }
 
-func (m *C2CSendRequest) GetTs() int64 {
-	if m != nil {
-		return m.Ts
-	}
-	return 0
-}
-
-func (m *C2CSendRequest) GetSeq() int64 {
-	if m != nil {
-		return m.Seq
-	}
-	return 0
-}
-
-type C2CSendResponse struct {
-	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+type AuthRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	DeviceId             string   `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform             string   `protobuf:"bytes,6,opt,name=platform,proto3" json:"platform,omitempty"`
+	GateId               string   `protobuf:"bytes,7,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2CSendResponse) Reset()         { *m = C2CSendResponse{} }
-func (m *C2CSendResponse) String() string { return proto.CompactTextString(m) }
-func (*C2CSendResponse) ProtoMessage()    {}
-func (*C2CSendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{6}
+func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
+func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRequest) ProtoMessage()    {}
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{7}
 }
 
-func (m *C2CSendResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2CSendResponse.Unmarshal(m, b)
+func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
 }
-func (m *C2CSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2CSendResponse.Marshal(b, m, deterministic)
+func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
 }
-func (m *C2CSendResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2CSendResponse.Merge(m, src)
+func (m *AuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRequest.Merge(m, src)
 }
-func (m *C2CSendResponse) XXX_Size() int {
-	return xxx_messageInfo_C2CSendResponse.Size(m)
+func (m *AuthRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthRequest.Size(m)
 }
-func (m *C2CSendResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2CSendResponse.DiscardUnknown(m)
+func (m *AuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2CSendResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
 
-func (m *C2CSendResponse) GetMsgId() int64 {
+func (m *AuthRequest) GetToken() string {
 	if m != nil {
-		return m.MsgId
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-func (m *C2CSendResponse) GetTs() int64 {
+func (m *AuthRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AuthRequest) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2CSendResponse) GetSeq() int64 {
+func (m *AuthRequest) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-type C2CPushResponse struct {
-	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *AuthRequest) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *AuthRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *AuthRequest) GetGateId() string {
+	if m != nil {
+		return m.GateId
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2CPushResponse) Reset()         { *m = C2CPushResponse{} }
-func (m *C2CPushResponse) String() string { return proto.CompactTextString(m) }
-func (*C2CPushResponse) ProtoMessage()    {}
-func (*C2CPushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{7}
+func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
+func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthResponse) ProtoMessage()    {}
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{8}
 }
 
-func (m *C2CPushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2CPushResponse.Unmarshal(m, b)
+func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
 }
-func (m *C2CPushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2CPushResponse.Marshal(b, m, deterministic)
+func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
 }
-func (m *C2CPushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2CPushResponse.Merge(m, src)
+func (m *AuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthResponse.Merge(m, src)
 }
-func (m *C2CPushResponse) XXX_Size() int {
-	return xxx_messageInfo_C2CPushResponse.Size(m)
+func (m *AuthResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthResponse.Size(m)
 }
-func (m *C2CPushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2CPushResponse.DiscardUnknown(m)
+func (m *AuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2CPushResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
 
-func (m *C2CPushResponse) GetMsgId() int64 {
+func (m *AuthResponse) GetStatus() int32 {
 	if m != nil {
-		return m.MsgId
+		return m.Status
 	}
 	return 0
 }
 
-func (m *C2CPushResponse) GetTs() int64 {
+func (m *AuthResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *AuthResponse) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2CPushResponse) GetSeq() int64 {
+func (m *AuthResponse) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-// 发送者发送群消息协议
-type C2GSendRequest struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
-	Content              string   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+type LogoutRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	DeviceId             string   `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2GSendRequest) Reset()         { *m = C2GSendRequest{} }
-func (m *C2GSendRequest) String() string { return proto.CompactTextString(m) }
-func (*C2GSendRequest) ProtoMessage()    {}
-func (*C2GSendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{8}
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{9}
 }
 
-func (m *C2GSendRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2GSendRequest.Unmarshal(m, b)
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
 }
-func (m *C2GSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2GSendRequest.Marshal(b, m, deterministic)
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
 }
-func (m *C2GSendRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2GSendRequest.Merge(m, src)
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
 }
-func (m *C2GSendRequest) XXX_Size() int {
-	return xxx_messageInfo_C2GSendRequest.Size(m)
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
 }
-func (m *C2GSendRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2GSendRequest.DiscardUnknown(m)
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2GSendRequest proto.InternalMessageInfo
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
 
-func (m *C2GSendRequest) GetFrom() string {
+func (m *LogoutRequest) GetToken() string {
 	if m != nil {
-		return m.From
+		return m.Token
 	}
 	return ""
 }
 
-func (m *C2GSendRequest) GetGroup() string {
+func (m *LogoutRequest) GetUid() string {
 	if m != nil {
-		return m.Group
+		return m.Uid
 	}
 	return ""
 }
 
-func (m *C2GSendRequest) GetContent() string {
+func (m *LogoutRequest) GetTs() int64 {
 	if m != nil {
-		return m.Content
+		return m.Ts
 	}
-	return ""
+	return 0
 }
 
-func (m *C2GSendRequest) GetTs() int64 {
+func (m *LogoutRequest) GetSeq() int64 {
 	if m != nil {
-		return m.Ts
+		return m.Seq
 	}
 	return 0
 }
 
-func (m *C2GSendRequest) GetSeq() int64 {
+func (m *LogoutRequest) GetDeviceId() string {
 	if m != nil {
-		return m.Seq
+		return m.DeviceId
 	}
-	return 0
+	return ""
 }
 
-type C2GSendResponse struct {
-	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+type LogoutResponse struct {
+	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2GSendResponse) Reset()         { *m = C2GSendResponse{} }
-func (m *C2GSendResponse) String() string { return proto.CompactTextString(m) }
-func (*C2GSendResponse) ProtoMessage()    {}
-func (*C2GSendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{9}
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{10}
 }
 
-func (m *C2GSendResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2GSendResponse.Unmarshal(m, b)
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
 }
-func (m *C2GSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2GSendResponse.Marshal(b, m, deterministic)
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
 }
-func (m *C2GSendResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2GSendResponse.Merge(m, src)
+func (m *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(m, src)
 }
-func (m *C2GSendResponse) XXX_Size() int {
-	return xxx_messageInfo_C2GSendResponse.Size(m)
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
 }
-func (m *C2GSendResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2GSendResponse.DiscardUnknown(m)
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2GSendResponse proto.InternalMessageInfo
-
-func (m *C2GSendResponse) GetMsgId() int64 {
-	if m != nil {
-		return m.MsgId
-	}
-	return 0
-}
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
 
-func (m *C2GSendResponse) GetTs() int64 {
+func (m *LogoutResponse) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2GSendResponse) GetSeq() int64 {
+func (m *LogoutResponse) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-type C2GPushResponse struct {
-	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// 发送者发送消息的协议
+type C2CSendRequest struct {
+	From                 string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Content              string      `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Ts                   int64       `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	ClientMsgId          string      `protobuf:"bytes,6,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	ContentType          ContentType `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=protocol.ContentType" json:"content_type,omitempty"`
+	Payload              *Payload    `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *C2GPushResponse) Reset()         { *m = C2GPushResponse{} }
-func (m *C2GPushResponse) String() string { return proto.CompactTextString(m) }
-func (*C2GPushResponse) ProtoMessage()    {}
-func (*C2GPushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{10}
+func (m *C2CSendRequest) Reset()         { *m = C2CSendRequest{} }
+func (m *C2CSendRequest) String() string { return proto.CompactTextString(m) }
+func (*C2CSendRequest) ProtoMessage()    {}
+func (*C2CSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{11}
 }
 
-func (m *C2GPushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2GPushResponse.Unmarshal(m, b)
+func (m *C2CSendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2CSendRequest.Unmarshal(m, b)
 }
-func (m *C2GPushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2GPushResponse.Marshal(b, m, deterministic)
+func (m *C2CSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2CSendRequest.Marshal(b, m, deterministic)
 }
-func (m *C2GPushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2GPushResponse.Merge(m, src)
+func (m *C2CSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2CSendRequest.Merge(m, src)
 }
-func (m *C2GPushResponse) XXX_Size() int {
-	return xxx_messageInfo_C2GPushResponse.Size(m)
+func (m *C2CSendRequest) XXX_Size() int {
+	return xxx_messageInfo_C2CSendRequest.Size(m)
 }
-func (m *C2GPushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2GPushResponse.DiscardUnknown(m)
+func (m *C2CSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2CSendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2GPushResponse proto.InternalMessageInfo
+var xxx_messageInfo_C2CSendRequest proto.InternalMessageInfo
 
-func (m *C2GPushResponse) GetMsgId() int64 {
+func (m *C2CSendRequest) GetFrom() string {
 	if m != nil {
-		return m.MsgId
+		return m.From
 	}
-	return 0
+	return ""
 }
 
-func (m *C2GPushResponse) GetTs() int64 {
+func (m *C2CSendRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *C2CSendRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *C2CSendRequest) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2GPushResponse) GetSeq() int64 {
+func (m *C2CSendRequest) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-type C2SPullMessageRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	MsgId                int64    `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+func (m *C2CSendRequest) GetClientMsgId() string {
+	if m != nil {
+		return m.ClientMsgId
+	}
+	return ""
+}
+
+func (m *C2CSendRequest) GetContentType() ContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return ContentType_TEXT
+}
+
+func (m *C2CSendRequest) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type C2CSendResponse struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConvSeq              int64    `protobuf:"varint,4,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	Status               int32    `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2SPullMessageRequest) Reset()         { *m = C2SPullMessageRequest{} }
-func (m *C2SPullMessageRequest) String() string { return proto.CompactTextString(m) }
-func (*C2SPullMessageRequest) ProtoMessage()    {}
-func (*C2SPullMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{11}
+func (m *C2CSendResponse) Reset()         { *m = C2CSendResponse{} }
+func (m *C2CSendResponse) String() string { return proto.CompactTextString(m) }
+func (*C2CSendResponse) ProtoMessage()    {}
+func (*C2CSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{12}
 }
 
-func (m *C2SPullMessageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2SPullMessageRequest.Unmarshal(m, b)
+func (m *C2CSendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2CSendResponse.Unmarshal(m, b)
 }
-func (m *C2SPullMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2SPullMessageRequest.Marshal(b, m, deterministic)
+func (m *C2CSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2CSendResponse.Marshal(b, m, deterministic)
 }
-func (m *C2SPullMessageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2SPullMessageRequest.Merge(m, src)
+func (m *C2CSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2CSendResponse.Merge(m, src)
 }
-func (m *C2SPullMessageRequest) XXX_Size() int {
-	return xxx_messageInfo_C2SPullMessageRequest.Size(m)
+func (m *C2CSendResponse) XXX_Size() int {
+	return xxx_messageInfo_C2CSendResponse.Size(m)
 }
-func (m *C2SPullMessageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2SPullMessageRequest.DiscardUnknown(m)
+func (m *C2CSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2CSendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2SPullMessageRequest proto.InternalMessageInfo
+var xxx_messageInfo_C2CSendResponse proto.InternalMessageInfo
 
-func (m *C2SPullMessageRequest) GetUid() string {
+func (m *C2CSendResponse) GetMsgId() int64 {
 	if m != nil {
-		return m.Uid
+		return m.MsgId
 	}
-	return ""
+	return 0
 }
 
-func (m *C2SPullMessageRequest) GetMsgId() int64 {
+func (m *C2CSendResponse) GetTs() int64 {
 	if m != nil {
-		return m.MsgId
+		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2SPullMessageRequest) GetLimit() int32 {
+func (m *C2CSendResponse) GetSeq() int64 {
 	if m != nil {
-		return m.Limit
+		return m.Seq
 	}
 	return 0
 }
 
-func (m *C2SPullMessageRequest) GetTs() int64 {
+func (m *C2CSendResponse) GetConvSeq() int64 {
 	if m != nil {
-		return m.Ts
+		return m.ConvSeq
 	}
 	return 0
 }
 
-func (m *C2SPullMessageRequest) GetSeq() int64 {
+func (m *C2CSendResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Seq
+		return m.Status
 	}
 	return 0
 }
 
-type PullMsg struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
-	Content              string   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	MsgId                int64    `protobuf:"varint,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	SendTime             int64    `protobuf:"varint,5,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
-	Ts                   int64    `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
+func (m *C2CSendResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type C2CPushResponse struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullMsg) Reset()         { *m = PullMsg{} }
-func (m *PullMsg) String() string { return proto.CompactTextString(m) }
-func (*PullMsg) ProtoMessage()    {}
-func (*PullMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{12}
+func (m *C2CPushResponse) Reset()         { *m = C2CPushResponse{} }
+func (m *C2CPushResponse) String() string { return proto.CompactTextString(m) }
+func (*C2CPushResponse) ProtoMessage()    {}
+func (*C2CPushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{13}
 }
 
-func (m *PullMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PullMsg.Unmarshal(m, b)
+func (m *C2CPushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2CPushResponse.Unmarshal(m, b)
 }
-func (m *PullMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PullMsg.Marshal(b, m, deterministic)
+func (m *C2CPushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2CPushResponse.Marshal(b, m, deterministic)
 }
-func (m *PullMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullMsg.Merge(m, src)
+func (m *C2CPushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2CPushResponse.Merge(m, src)
 }
-func (m *PullMsg) XXX_Size() int {
-	return xxx_messageInfo_PullMsg.Size(m)
+func (m *C2CPushResponse) XXX_Size() int {
+	return xxx_messageInfo_C2CPushResponse.Size(m)
 }
-func (m *PullMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullMsg.DiscardUnknown(m)
+func (m *C2CPushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2CPushResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullMsg proto.InternalMessageInfo
+var xxx_messageInfo_C2CPushResponse proto.InternalMessageInfo
 
-func (m *PullMsg) GetFrom() string {
+func (m *C2CPushResponse) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *C2CPushResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2CPushResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *C2CPushResponse) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+// 发送者发送群消息协议
+type C2GSendRequest struct {
+	From                 string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Group                string      `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Content              string      `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Ts                   int64       `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	ClientMsgId          string      `protobuf:"bytes,6,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	ContentType          ContentType `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=protocol.ContentType" json:"content_type,omitempty"`
+	Payload              *Payload    `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *C2GSendRequest) Reset()         { *m = C2GSendRequest{} }
+func (m *C2GSendRequest) String() string { return proto.CompactTextString(m) }
+func (*C2GSendRequest) ProtoMessage()    {}
+func (*C2GSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{14}
+}
+
+func (m *C2GSendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2GSendRequest.Unmarshal(m, b)
+}
+func (m *C2GSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2GSendRequest.Marshal(b, m, deterministic)
+}
+func (m *C2GSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2GSendRequest.Merge(m, src)
+}
+func (m *C2GSendRequest) XXX_Size() int {
+	return xxx_messageInfo_C2GSendRequest.Size(m)
+}
+func (m *C2GSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2GSendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2GSendRequest proto.InternalMessageInfo
+
+func (m *C2GSendRequest) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *PullMsg) GetGroup() string {
+func (m *C2GSendRequest) GetGroup() string {
 	if m != nil {
 		return m.Group
 	}
 	return ""
 }
 
-func (m *PullMsg) GetContent() string {
+func (m *C2GSendRequest) GetContent() string {
 	if m != nil {
 		return m.Content
 	}
 	return ""
 }
 
-func (m *PullMsg) GetMsgId() int64 {
+func (m *C2GSendRequest) GetTs() int64 {
 	if m != nil {
-		return m.MsgId
+		return m.Ts
 	}
 	return 0
 }
 
-func (m *PullMsg) GetSendTime() int64 {
+func (m *C2GSendRequest) GetSeq() int64 {
 	if m != nil {
-		return m.SendTime
+		return m.Seq
 	}
 	return 0
 }
 
-func (m *PullMsg) GetTs() int64 {
+func (m *C2GSendRequest) GetClientMsgId() string {
 	if m != nil {
-		return m.Ts
+		return m.ClientMsgId
 	}
-	return 0
+	return ""
 }
 
-func (m *PullMsg) GetSeq() int64 {
+func (m *C2GSendRequest) GetContentType() ContentType {
 	if m != nil {
-		return m.Seq
+		return m.ContentType
 	}
-	return 0
+	return ContentType_TEXT
 }
 
-type C2SPullMessageResponse struct {
-	Msg                  []*PullMsg `protobuf:"bytes,1,rep,name=msg,proto3" json:"msg,omitempty"`
-	Ts                   int64      `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64      `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *C2GSendRequest) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-func (m *C2SPullMessageResponse) Reset()         { *m = C2SPullMessageResponse{} }
-func (m *C2SPullMessageResponse) String() string { return proto.CompactTextString(m) }
-func (*C2SPullMessageResponse) ProtoMessage()    {}
-func (*C2SPullMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60207fea82c31ca8, []int{13}
+type C2GSendResponse struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConvSeq              int64    `protobuf:"varint,4,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	Status               int32    `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *C2SPullMessageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2SPullMessageResponse.Unmarshal(m, b)
+func (m *C2GSendResponse) Reset()         { *m = C2GSendResponse{} }
+func (m *C2GSendResponse) String() string { return proto.CompactTextString(m) }
+func (*C2GSendResponse) ProtoMessage()    {}
+func (*C2GSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{15}
 }
-func (m *C2SPullMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2SPullMessageResponse.Marshal(b, m, deterministic)
+
+func (m *C2GSendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2GSendResponse.Unmarshal(m, b)
 }
-func (m *C2SPullMessageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2SPullMessageResponse.Merge(m, src)
+func (m *C2GSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2GSendResponse.Marshal(b, m, deterministic)
 }
-func (m *C2SPullMessageResponse) XXX_Size() int {
-	return xxx_messageInfo_C2SPullMessageResponse.Size(m)
+func (m *C2GSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2GSendResponse.Merge(m, src)
 }
-func (m *C2SPullMessageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2SPullMessageResponse.DiscardUnknown(m)
+func (m *C2GSendResponse) XXX_Size() int {
+	return xxx_messageInfo_C2GSendResponse.Size(m)
+}
+func (m *C2GSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2GSendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2SPullMessageResponse proto.InternalMessageInfo
+var xxx_messageInfo_C2GSendResponse proto.InternalMessageInfo
 
-func (m *C2SPullMessageResponse) GetMsg() []*PullMsg {
+func (m *C2GSendResponse) GetMsgId() int64 {
 	if m != nil {
-		return m.Msg
+		return m.MsgId
 	}
-	return nil
+	return 0
 }
 
-func (m *C2SPullMessageResponse) GetTs() int64 {
+func (m *C2GSendResponse) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2SPullMessageResponse) GetSeq() int64 {
+func (m *C2GSendResponse) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Response)(nil), "protocol.Response")
-	proto.RegisterType((*AuthRequest)(nil), "protocol.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "protocol.AuthResponse")
-	proto.RegisterType((*LogoutRequest)(nil), "protocol.LogoutRequest")
-	proto.RegisterType((*LogoutResponse)(nil), "protocol.LogoutResponse")
-	proto.RegisterType((*C2CSendRequest)(nil), "protocol.C2CSendRequest")
-	proto.RegisterType((*C2CSendResponse)(nil), "protocol.C2CSendResponse")
-	proto.RegisterType((*C2CPushResponse)(nil), "protocol.C2CPushResponse")
-	proto.RegisterType((*C2GSendRequest)(nil), "protocol.C2GSendRequest")
-	proto.RegisterType((*C2GSendResponse)(nil), "protocol.C2GSendResponse")
-	proto.RegisterType((*C2GPushResponse)(nil), "protocol.C2GPushResponse")
-	proto.RegisterType((*C2SPullMessageRequest)(nil), "protocol.C2SPullMessageRequest")
-	proto.RegisterType((*PullMsg)(nil), "protocol.PullMsg")
-	proto.RegisterType((*C2SPullMessageResponse)(nil), "protocol.C2SPullMessageResponse")
+func (m *C2GSendResponse) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("logic.proto", fileDescriptor_60207fea82c31ca8) }
-
-var fileDescriptor_60207fea82c31ca8 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0x55, 0x7e, 0xb6, 0xfd, 0x3a, 0x0a, 0x58, 0x6d, 0x29, 0xe1, 0x40, 0x35, 0x2e, 0x3b, 0xa0,
-	0x1e, 0x82, 0x38, 0x21, 0x21, 0x4d, 0x3d, 0x44, 0xa0, 0x22, 0x4d, 0x29, 0x12, 0x82, 0x4b, 0x35,
-	0x52, 0x93, 0x45, 0x4b, 0xe2, 0xae, 0x76, 0x26, 0xfe, 0x1f, 0xfe, 0x1b, 0xfe, 0x2a, 0x64, 0xe7,
-	0xcb, 0x9c, 0x64, 0x1d, 0xab, 0xd4, 0x9e, 0xe2, 0xcf, 0xf6, 0x7b, 0xdf, 0xcb, 0xb3, 0xfd, 0xa0,
-	0x9f, 0xb2, 0x38, 0x89, 0x66, 0x9b, 0x2d, 0x13, 0x8c, 0x74, 0xd5, 0x27, 0x62, 0xe9, 0xe9, 0x5b,
-	0xe8, 0x86, 0x94, 0x6f, 0x58, 0xce, 0x29, 0x19, 0x80, 0x29, 0xf8, 0xc4, 0x98, 0x1a, 0x67, 0x56,
-	0x68, 0x0a, 0x4e, 0x9e, 0x81, 0xc5, 0xe9, 0xcd, 0xc4, 0x54, 0x13, 0x72, 0x78, 0xfa, 0x0d, 0xfa,
-	0xe7, 0x85, 0xb8, 0x0a, 0xe9, 0x4d, 0x41, 0xb9, 0x20, 0x43, 0x70, 0x04, 0xbb, 0xa6, 0xb9, 0xc2,
-	0xf4, 0xc2, 0xb2, 0x90, 0xb0, 0x22, 0x59, 0x2b, 0x58, 0x2f, 0x94, 0x43, 0x24, 0xb6, 0xda, 0xc4,
-	0xb6, 0x26, 0xfe, 0x01, 0x27, 0x25, 0x31, 0x4a, 0x19, 0x83, 0xcb, 0xc5, 0xa5, 0x28, 0x4a, 0x39,
-	0x4e, 0x88, 0x95, 0x44, 0x66, 0x3c, 0xae, 0xb8, 0x33, 0x1e, 0xef, 0xc1, 0xfd, 0x1d, 0x9e, 0x2c,
-	0x58, 0xcc, 0x0a, 0x71, 0x7c, 0xd9, 0x3e, 0x0c, 0x2a, 0xea, 0xbd, 0x3d, 0xdc, 0xc0, 0x60, 0xee,
-	0xcf, 0x97, 0x34, 0x5f, 0x57, 0x7a, 0x08, 0xd8, 0xbf, 0xb6, 0x2c, 0x43, 0x39, 0x6a, 0xac, 0x78,
-	0x18, 0x8a, 0x31, 0x05, 0x23, 0x13, 0xe8, 0x44, 0x2c, 0x17, 0x34, 0x17, 0x4a, 0x50, 0x2f, 0xac,
-	0x4a, 0xec, 0x68, 0xb7, 0x3b, 0x3a, 0xba, 0xe3, 0x67, 0x78, 0x7a, 0xd7, 0x11, 0x65, 0x8e, 0xc0,
-	0xcd, 0x78, 0xbc, 0x4a, 0xd6, 0x28, 0xd5, 0xc9, 0x78, 0xfc, 0xa9, 0xfa, 0x63, 0xb3, 0xcd, 0x65,
-	0xb5, 0xb9, 0x2e, 0x0a, 0x7e, 0x75, 0x38, 0xd7, 0xad, 0x74, 0x22, 0x78, 0xcc, 0x89, 0x21, 0x38,
-	0xf1, 0x96, 0x15, 0x1b, 0x34, 0xa3, 0x2c, 0x0e, 0xf7, 0x23, 0x38, 0xa2, 0x1f, 0xc1, 0x71, 0xfc,
-	0xf8, 0x0d, 0xa3, 0xb9, 0xbf, 0xbc, 0x28, 0xd2, 0xf4, 0x0b, 0xe5, 0xfc, 0x32, 0xa6, 0x95, 0x2d,
-	0x78, 0x35, 0x0d, 0x7d, 0x35, 0x75, 0x0f, 0xb3, 0xde, 0x63, 0x08, 0x4e, 0x9a, 0x64, 0x49, 0xe9,
-	0x89, 0x13, 0x96, 0xc5, 0x1e, 0x8e, 0xfc, 0x31, 0xa0, 0xa3, 0xfa, 0xf2, 0xf8, 0x28, 0x67, 0xa0,
-	0xe5, 0xd9, 0x75, 0x79, 0xaf, 0xa0, 0xc7, 0x69, 0xbe, 0x5e, 0x89, 0x24, 0xa3, 0xd8, 0xbe, 0x2b,
-	0x27, 0xbe, 0x26, 0x59, 0xf5, 0x72, 0xdc, 0xb6, 0xca, 0x8e, 0x56, 0xb9, 0x82, 0x71, 0xdb, 0x1f,
-	0xb4, 0xfc, 0x4d, 0x19, 0x0b, 0xc6, 0xd4, 0x3a, 0xeb, 0xfb, 0xcf, 0x67, 0x55, 0xba, 0xcd, 0xf0,
-	0x9f, 0xea, 0x49, 0xf1, 0x9f, 0x03, 0xf0, 0xff, 0x5a, 0x70, 0xb2, 0x90, 0x31, 0xb9, 0xa4, 0xdb,
-	0xdb, 0x24, 0xa2, 0xe4, 0x3d, 0xd8, 0x32, 0x96, 0xc8, 0x48, 0x53, 0xd6, 0xf2, 0xcf, 0x1b, 0xb7,
-	0xa7, 0x51, 0xce, 0x07, 0x70, 0xcb, 0x58, 0x20, 0x2f, 0xf4, 0x8e, 0x46, 0x06, 0x79, 0x93, 0xfb,
-	0x0b, 0x08, 0xfe, 0x08, 0x1d, 0x7c, 0xad, 0xa4, 0xb6, 0xa9, 0x19, 0x19, 0xde, 0xcb, 0x1d, 0x2b,
-	0x75, 0x7c, 0x70, 0x1f, 0x1f, 0x3c, 0x88, 0x6f, 0x3e, 0x85, 0x85, 0xc4, 0x2b, 0x97, 0xc9, 0xeb,
-	0xfa, 0xae, 0x1d, 0x17, 0xd3, 0x9b, 0x3e, 0xbc, 0xe1, 0xce, 0x0a, 0xc0, 0xbc, 0x38, 0x8f, 0xae,
-	0x49, 0x53, 0x76, 0xfd, 0xd5, 0x78, 0x44, 0x2f, 0x35, 0xc1, 0xc1, 0x4e, 0x70, 0xf0, 0x18, 0xf8,
-	0xa7, 0xab, 0xa6, 0xde, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x59, 0xb9, 0xbb, 0xf9, 0x06,
-	0x00, 0x00,
+func (m *C2GSendResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *C2GSendResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
 
-// LogicServiceClient is the client API for LogicService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LogicServiceClient interface {
-	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
-	C2CSend(ctx context.Context, in *C2CSendRequest, opts ...grpc.CallOption) (*C2CSendResponse, error)
-	C2GSend(ctx context.Context, in *C2GSendRequest, opts ...grpc.CallOption) (*C2GSendResponse, error)
-	C2SPull(ctx context.Context, in *C2SPullMessageRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error)
-	C2CPushAck(ctx context.Context, in *C2CPushResponse, opts ...grpc.CallOption) (*Response, error)
-	C2GPushAck(ctx context.Context, in *C2GPushResponse, opts ...grpc.CallOption) (*Response, error)
+type C2GPushResponse struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type logicServiceClient struct {
-	cc *grpc.ClientConn
+func (m *C2GPushResponse) Reset()         { *m = C2GPushResponse{} }
+func (m *C2GPushResponse) String() string { return proto.CompactTextString(m) }
+func (*C2GPushResponse) ProtoMessage()    {}
+func (*C2GPushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{16}
 }
 
-func NewLogicServiceClient(cc *grpc.ClientConn) LogicServiceClient {
-	return &logicServiceClient{cc}
+func (m *C2GPushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2GPushResponse.Unmarshal(m, b)
+}
+func (m *C2GPushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2GPushResponse.Marshal(b, m, deterministic)
+}
+func (m *C2GPushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2GPushResponse.Merge(m, src)
+}
+func (m *C2GPushResponse) XXX_Size() int {
+	return xxx_messageInfo_C2GPushResponse.Size(m)
+}
+func (m *C2GPushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2GPushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2GPushResponse proto.InternalMessageInfo
+
+func (m *C2GPushResponse) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *C2GPushResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2GPushResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *C2GPushResponse) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type C2SPullMessageRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MsgId                int64    `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *C2SPullMessageRequest) Reset()         { *m = C2SPullMessageRequest{} }
+func (m *C2SPullMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*C2SPullMessageRequest) ProtoMessage()    {}
+func (*C2SPullMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{17}
+}
+
+func (m *C2SPullMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2SPullMessageRequest.Unmarshal(m, b)
+}
+func (m *C2SPullMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2SPullMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *C2SPullMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2SPullMessageRequest.Merge(m, src)
+}
+func (m *C2SPullMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_C2SPullMessageRequest.Size(m)
+}
+func (m *C2SPullMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2SPullMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2SPullMessageRequest proto.InternalMessageInfo
+
+func (m *C2SPullMessageRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *C2SPullMessageRequest) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *C2SPullMessageRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *C2SPullMessageRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2SPullMessageRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type PullMsg struct {
+	From                 string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Group                string      `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Content              string      `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MsgId                int64       `protobuf:"varint,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	SendTime             int64       `protobuf:"varint,5,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	Ts                   int64       `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConvSeq              int64       `protobuf:"varint,8,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	To                   string      `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	MsgType              int32       `protobuf:"varint,10,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	Recalled             bool        `protobuf:"varint,11,opt,name=recalled,proto3" json:"recalled,omitempty"`
+	ContentType          ContentType `protobuf:"varint,12,opt,name=content_type,json=contentType,proto3,enum=protocol.ContentType" json:"content_type,omitempty"`
+	Payload              *Payload    `protobuf:"bytes,13,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PullMsg) Reset()         { *m = PullMsg{} }
+func (m *PullMsg) String() string { return proto.CompactTextString(m) }
+func (*PullMsg) ProtoMessage()    {}
+func (*PullMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{18}
+}
+
+func (m *PullMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullMsg.Unmarshal(m, b)
+}
+func (m *PullMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullMsg.Marshal(b, m, deterministic)
+}
+func (m *PullMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullMsg.Merge(m, src)
+}
+func (m *PullMsg) XXX_Size() int {
+	return xxx_messageInfo_PullMsg.Size(m)
+}
+func (m *PullMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullMsg proto.InternalMessageInfo
+
+func (m *PullMsg) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *PullMsg) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *PullMsg) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *PullMsg) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *PullMsg) GetSendTime() int64 {
+	if m != nil {
+		return m.SendTime
+	}
+	return 0
+}
+
+func (m *PullMsg) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *PullMsg) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PullMsg) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
+}
+
+func (m *PullMsg) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *PullMsg) GetMsgType() int32 {
+	if m != nil {
+		return m.MsgType
+	}
+	return 0
+}
+
+func (m *PullMsg) GetRecalled() bool {
+	if m != nil {
+		return m.Recalled
+	}
+	return false
+}
+
+func (m *PullMsg) GetContentType() ContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return ContentType_TEXT
+}
+
+func (m *PullMsg) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type C2SPullMessageResponse struct {
+	Msg                  []*PullMsg `protobuf:"bytes,1,rep,name=msg,proto3" json:"msg,omitempty"`
+	Ts                   int64      `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64      `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *C2SPullMessageResponse) Reset()         { *m = C2SPullMessageResponse{} }
+func (m *C2SPullMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*C2SPullMessageResponse) ProtoMessage()    {}
+func (*C2SPullMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{19}
+}
+
+func (m *C2SPullMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2SPullMessageResponse.Unmarshal(m, b)
+}
+func (m *C2SPullMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2SPullMessageResponse.Marshal(b, m, deterministic)
+}
+func (m *C2SPullMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2SPullMessageResponse.Merge(m, src)
+}
+func (m *C2SPullMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_C2SPullMessageResponse.Size(m)
+}
+func (m *C2SPullMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2SPullMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2SPullMessageResponse proto.InternalMessageInfo
+
+func (m *C2SPullMessageResponse) GetMsg() []*PullMsg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *C2SPullMessageResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2SPullMessageResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 按会话序列号拉取消息，客户端发现序列号不连续时用来补齐缺失的消息
+type PullBySeqRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Peer                 string   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	FromSeq              int64    `protobuf:"varint,4,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+	ToSeq                int64    `protobuf:"varint,5,opt,name=to_seq,json=toSeq,proto3" json:"to_seq,omitempty"`
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Ts                   int64    `protobuf:"varint,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullBySeqRequest) Reset()         { *m = PullBySeqRequest{} }
+func (m *PullBySeqRequest) String() string { return proto.CompactTextString(m) }
+func (*PullBySeqRequest) ProtoMessage()    {}
+func (*PullBySeqRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{20}
+}
+
+func (m *PullBySeqRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullBySeqRequest.Unmarshal(m, b)
+}
+func (m *PullBySeqRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullBySeqRequest.Marshal(b, m, deterministic)
+}
+func (m *PullBySeqRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullBySeqRequest.Merge(m, src)
+}
+func (m *PullBySeqRequest) XXX_Size() int {
+	return xxx_messageInfo_PullBySeqRequest.Size(m)
+}
+func (m *PullBySeqRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullBySeqRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullBySeqRequest proto.InternalMessageInfo
+
+func (m *PullBySeqRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PullBySeqRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *PullBySeqRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *PullBySeqRequest) GetFromSeq() int64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
+}
+
+func (m *PullBySeqRequest) GetToSeq() int64 {
+	if m != nil {
+		return m.ToSeq
+	}
+	return 0
+}
+
+func (m *PullBySeqRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PullBySeqRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *PullBySeqRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 群管理操作的应答
+type GroupResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupResponse) Reset()         { *m = GroupResponse{} }
+func (m *GroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GroupResponse) ProtoMessage()    {}
+func (*GroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{21}
+}
+
+func (m *GroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupResponse.Unmarshal(m, b)
+}
+func (m *GroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupResponse.Marshal(b, m, deterministic)
+}
+func (m *GroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupResponse.Merge(m, src)
+}
+func (m *GroupResponse) XXX_Size() int {
+	return xxx_messageInfo_GroupResponse.Size(m)
+}
+func (m *GroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupResponse proto.InternalMessageInfo
+
+func (m *GroupResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GroupResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *GroupResponse) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *GroupResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *GroupResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 建群，创建者自动成为群主
+type CreateGroupRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members              []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	Open                 bool     `protobuf:"varint,6,opt,name=open,proto3" json:"open,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
+func (m *CreateGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupRequest) ProtoMessage()    {}
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{22}
+}
+
+func (m *CreateGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupRequest.Unmarshal(m, b)
+}
+func (m *CreateGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupRequest.Merge(m, src)
+}
+func (m *CreateGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupRequest.Size(m)
+}
+func (m *CreateGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupRequest proto.InternalMessageInfo
+
+func (m *CreateGroupRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *CreateGroupRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *CreateGroupRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *CreateGroupRequest) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
+}
+
+type RenameGroupRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameGroupRequest) Reset()         { *m = RenameGroupRequest{} }
+func (m *RenameGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameGroupRequest) ProtoMessage()    {}
+func (*RenameGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{23}
+}
+
+func (m *RenameGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameGroupRequest.Unmarshal(m, b)
+}
+func (m *RenameGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameGroupRequest.Merge(m, src)
+}
+func (m *RenameGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameGroupRequest.Size(m)
+}
+func (m *RenameGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameGroupRequest proto.InternalMessageInfo
+
+func (m *RenameGroupRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RenameGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *RenameGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenameGroupRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *RenameGroupRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 解散、加入、退出群和查询群成员
+type GroupRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupRequest) Reset()         { *m = GroupRequest{} }
+func (m *GroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GroupRequest) ProtoMessage()    {}
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{24}
+}
+
+func (m *GroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupRequest.Unmarshal(m, b)
+}
+func (m *GroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupRequest.Marshal(b, m, deterministic)
+}
+func (m *GroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRequest.Merge(m, src)
+}
+func (m *GroupRequest) XXX_Size() int {
+	return xxx_messageInfo_GroupRequest.Size(m)
+}
+func (m *GroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupRequest proto.InternalMessageInfo
+
+func (m *GroupRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *GroupRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *GroupRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 邀请或移除群成员
+type GroupMembersRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Members              []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupMembersRequest) Reset()         { *m = GroupMembersRequest{} }
+func (m *GroupMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*GroupMembersRequest) ProtoMessage()    {}
+func (*GroupMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{25}
+}
+
+func (m *GroupMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupMembersRequest.Unmarshal(m, b)
+}
+func (m *GroupMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupMembersRequest.Marshal(b, m, deterministic)
+}
+func (m *GroupMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMembersRequest.Merge(m, src)
+}
+func (m *GroupMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_GroupMembersRequest.Size(m)
+}
+func (m *GroupMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMembersRequest proto.InternalMessageInfo
+
+func (m *GroupMembersRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GroupMembersRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *GroupMembersRequest) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *GroupMembersRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *GroupMembersRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type GroupMember struct {
+	Uid                  string    `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role                 GroupRole `protobuf:"varint,2,opt,name=role,proto3,enum=protocol.GroupRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GroupMember) Reset()         { *m = GroupMember{} }
+func (m *GroupMember) String() string { return proto.CompactTextString(m) }
+func (*GroupMember) ProtoMessage()    {}
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{26}
+}
+
+func (m *GroupMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupMember.Unmarshal(m, b)
+}
+func (m *GroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupMember.Marshal(b, m, deterministic)
+}
+func (m *GroupMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMember.Merge(m, src)
+}
+func (m *GroupMember) XXX_Size() int {
+	return xxx_messageInfo_GroupMember.Size(m)
+}
+func (m *GroupMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMember proto.InternalMessageInfo
+
+func (m *GroupMember) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GroupMember) GetRole() GroupRole {
+	if m != nil {
+		return m.Role
+	}
+	return GroupRole_MEMBER
+}
+
+type ListGroupMembersResponse struct {
+	Status               int32          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string         `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Members              []*GroupMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Ts                   int64          `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64          `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListGroupMembersResponse) Reset()         { *m = ListGroupMembersResponse{} }
+func (m *ListGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGroupMembersResponse) ProtoMessage()    {}
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{27}
+}
+
+func (m *ListGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupMembersResponse.Unmarshal(m, b)
+}
+func (m *ListGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupMembersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupMembersResponse.Merge(m, src)
+}
+func (m *ListGroupMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGroupMembersResponse.Size(m)
+}
+func (m *ListGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGroupMembersResponse proto.InternalMessageInfo
+
+func (m *ListGroupMembersResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListGroupMembersResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *ListGroupMembersResponse) GetMembers() []*GroupMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *ListGroupMembersResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ListGroupMembersResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ListUserGroupsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUserGroupsRequest) Reset()         { *m = ListUserGroupsRequest{} }
+func (m *ListUserGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserGroupsRequest) ProtoMessage()    {}
+func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{28}
+}
+
+func (m *ListUserGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserGroupsRequest.Unmarshal(m, b)
+}
+func (m *ListUserGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUserGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserGroupsRequest.Merge(m, src)
+}
+func (m *ListUserGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserGroupsRequest.Size(m)
+}
+func (m *ListUserGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserGroupsRequest proto.InternalMessageInfo
+
+func (m *ListUserGroupsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ListUserGroupsRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ListUserGroupsRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type GroupInfo struct {
+	Group                string    `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Name                 string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 GroupRole `protobuf:"varint,3,opt,name=role,proto3,enum=protocol.GroupRole" json:"role,omitempty"`
+	Muted                bool      `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
+func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
+func (*GroupInfo) ProtoMessage()    {}
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{29}
+}
+
+func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupInfo.Unmarshal(m, b)
+}
+func (m *GroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupInfo.Marshal(b, m, deterministic)
+}
+func (m *GroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupInfo.Merge(m, src)
+}
+func (m *GroupInfo) XXX_Size() int {
+	return xxx_messageInfo_GroupInfo.Size(m)
+}
+func (m *GroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupInfo proto.InternalMessageInfo
+
+func (m *GroupInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *GroupInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GroupInfo) GetRole() GroupRole {
+	if m != nil {
+		return m.Role
+	}
+	return GroupRole_MEMBER
+}
+
+func (m *GroupInfo) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+type ListUserGroupsResponse struct {
+	Groups               []*GroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Ts                   int64        `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64        `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListUserGroupsResponse) Reset()         { *m = ListUserGroupsResponse{} }
+func (m *ListUserGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUserGroupsResponse) ProtoMessage()    {}
+func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{30}
+}
+
+func (m *ListUserGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserGroupsResponse.Unmarshal(m, b)
+}
+func (m *ListUserGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUserGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserGroupsResponse.Merge(m, src)
+}
+func (m *ListUserGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUserGroupsResponse.Size(m)
+}
+func (m *ListUserGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserGroupsResponse proto.InternalMessageInfo
+
+func (m *ListUserGroupsResponse) GetGroups() []*GroupInfo {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *ListUserGroupsResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ListUserGroupsResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 禁言或解除禁言，禁言后只有管理员和群主可以发言
+type MuteGroupRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Muted                bool     `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MuteGroupRequest) Reset()         { *m = MuteGroupRequest{} }
+func (m *MuteGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*MuteGroupRequest) ProtoMessage()    {}
+func (*MuteGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{31}
+}
+
+func (m *MuteGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MuteGroupRequest.Unmarshal(m, b)
+}
+func (m *MuteGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MuteGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *MuteGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuteGroupRequest.Merge(m, src)
+}
+func (m *MuteGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_MuteGroupRequest.Size(m)
+}
+func (m *MuteGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuteGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MuteGroupRequest proto.InternalMessageInfo
+
+func (m *MuteGroupRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *MuteGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *MuteGroupRequest) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+func (m *MuteGroupRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *MuteGroupRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 设置或取消管理员
+type SetGroupAdminRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Member               string   `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Admin                bool     `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	Ts                   int64    `protobuf:"varint,5,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetGroupAdminRequest) Reset()         { *m = SetGroupAdminRequest{} }
+func (m *SetGroupAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*SetGroupAdminRequest) ProtoMessage()    {}
+func (*SetGroupAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{32}
+}
+
+func (m *SetGroupAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupAdminRequest.Unmarshal(m, b)
+}
+func (m *SetGroupAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *SetGroupAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupAdminRequest.Merge(m, src)
+}
+func (m *SetGroupAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_SetGroupAdminRequest.Size(m)
+}
+func (m *SetGroupAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupAdminRequest proto.InternalMessageInfo
+
+func (m *SetGroupAdminRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetGroupAdminRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *SetGroupAdminRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *SetGroupAdminRequest) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
+	}
+	return false
+}
+
+func (m *SetGroupAdminRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *SetGroupAdminRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 转让群，原群主成为管理员
+type TransferGroupOwnerRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferGroupOwnerRequest) Reset()         { *m = TransferGroupOwnerRequest{} }
+func (m *TransferGroupOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferGroupOwnerRequest) ProtoMessage()    {}
+func (*TransferGroupOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{33}
+}
+
+func (m *TransferGroupOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferGroupOwnerRequest.Unmarshal(m, b)
+}
+func (m *TransferGroupOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferGroupOwnerRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferGroupOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferGroupOwnerRequest.Merge(m, src)
+}
+func (m *TransferGroupOwnerRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferGroupOwnerRequest.Size(m)
+}
+func (m *TransferGroupOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferGroupOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferGroupOwnerRequest proto.InternalMessageInfo
+
+func (m *TransferGroupOwnerRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *TransferGroupOwnerRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *TransferGroupOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TransferGroupOwnerRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *TransferGroupOwnerRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 撤回消息，发送者可以撤回自己的消息，群管理员和群主可以撤回群里的任何消息
+type RecallRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MsgId                int64    `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecallRequest) Reset()         { *m = RecallRequest{} }
+func (m *RecallRequest) String() string { return proto.CompactTextString(m) }
+func (*RecallRequest) ProtoMessage()    {}
+func (*RecallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{34}
+}
+
+func (m *RecallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallRequest.Unmarshal(m, b)
+}
+func (m *RecallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallRequest.Marshal(b, m, deterministic)
+}
+func (m *RecallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallRequest.Merge(m, src)
+}
+func (m *RecallRequest) XXX_Size() int {
+	return xxx_messageInfo_RecallRequest.Size(m)
+}
+func (m *RecallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallRequest proto.InternalMessageInfo
+
+func (m *RecallRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RecallRequest) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *RecallRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *RecallRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type RecallResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecallResponse) Reset()         { *m = RecallResponse{} }
+func (m *RecallResponse) String() string { return proto.CompactTextString(m) }
+func (*RecallResponse) ProtoMessage()    {}
+func (*RecallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{35}
+}
+
+func (m *RecallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallResponse.Unmarshal(m, b)
+}
+func (m *RecallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallResponse.Marshal(b, m, deterministic)
+}
+func (m *RecallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallResponse.Merge(m, src)
+}
+func (m *RecallResponse) XXX_Size() int {
+	return xxx_messageInfo_RecallResponse.Size(m)
+}
+func (m *RecallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallResponse proto.InternalMessageInfo
+
+func (m *RecallResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *RecallResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *RecallResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *RecallResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 把会话中到conv_seq为止收到的消息标记为已读，发送者会收到已读回执
+type MarkReadRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Peer                 string   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	ConvSeq              int64    `protobuf:"varint,4,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	Ts                   int64    `protobuf:"varint,5,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadRequest) Reset()         { *m = MarkReadRequest{} }
+func (m *MarkReadRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkReadRequest) ProtoMessage()    {}
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{36}
+}
+
+func (m *MarkReadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadRequest.Unmarshal(m, b)
+}
+func (m *MarkReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadRequest.Merge(m, src)
+}
+func (m *MarkReadRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkReadRequest.Size(m)
+}
+func (m *MarkReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadRequest proto.InternalMessageInfo
+
+func (m *MarkReadRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *MarkReadRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *MarkReadRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *MarkReadRequest) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
+}
+
+func (m *MarkReadRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *MarkReadRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type MarkReadResponse struct {
+	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadResponse) Reset()         { *m = MarkReadResponse{} }
+func (m *MarkReadResponse) String() string { return proto.CompactTextString(m) }
+func (*MarkReadResponse) ProtoMessage()    {}
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{37}
+}
+
+func (m *MarkReadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadResponse.Unmarshal(m, b)
+}
+func (m *MarkReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadResponse.Marshal(b, m, deterministic)
+}
+func (m *MarkReadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadResponse.Merge(m, src)
+}
+func (m *MarkReadResponse) XXX_Size() int {
+	return xxx_messageInfo_MarkReadResponse.Size(m)
+}
+func (m *MarkReadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadResponse proto.InternalMessageInfo
+
+func (m *MarkReadResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MarkReadResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *MarkReadResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 查询群消息的已读人数
+type ReadCountsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	MsgIds               []int64  `protobuf:"varint,3,rep,packed,name=msg_ids,json=msgIds,proto3" json:"msg_ids,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadCountsRequest) Reset()         { *m = ReadCountsRequest{} }
+func (m *ReadCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadCountsRequest) ProtoMessage()    {}
+func (*ReadCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{38}
+}
+
+func (m *ReadCountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadCountsRequest.Unmarshal(m, b)
+}
+func (m *ReadCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadCountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadCountsRequest.Merge(m, src)
+}
+func (m *ReadCountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadCountsRequest.Size(m)
+}
+func (m *ReadCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadCountsRequest proto.InternalMessageInfo
+
+func (m *ReadCountsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReadCountsRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ReadCountsRequest) GetMsgIds() []int64 {
+	if m != nil {
+		return m.MsgIds
+	}
+	return nil
+}
+
+func (m *ReadCountsRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ReadCountsRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ReadCount struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	ReadCount            int32    `protobuf:"varint,2,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`
+	Total                int32    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadCount) Reset()         { *m = ReadCount{} }
+func (m *ReadCount) String() string { return proto.CompactTextString(m) }
+func (*ReadCount) ProtoMessage()    {}
+func (*ReadCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{39}
+}
+
+func (m *ReadCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadCount.Unmarshal(m, b)
+}
+func (m *ReadCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadCount.Marshal(b, m, deterministic)
+}
+func (m *ReadCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadCount.Merge(m, src)
+}
+func (m *ReadCount) XXX_Size() int {
+	return xxx_messageInfo_ReadCount.Size(m)
+}
+func (m *ReadCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadCount proto.InternalMessageInfo
+
+func (m *ReadCount) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *ReadCount) GetReadCount() int32 {
+	if m != nil {
+		return m.ReadCount
+	}
+	return 0
+}
+
+func (m *ReadCount) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ReadCountsResponse struct {
+	Counts               []*ReadCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	Ts                   int64        `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64        `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReadCountsResponse) Reset()         { *m = ReadCountsResponse{} }
+func (m *ReadCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadCountsResponse) ProtoMessage()    {}
+func (*ReadCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{40}
+}
+
+func (m *ReadCountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadCountsResponse.Unmarshal(m, b)
+}
+func (m *ReadCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadCountsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadCountsResponse.Merge(m, src)
+}
+func (m *ReadCountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadCountsResponse.Size(m)
+}
+func (m *ReadCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadCountsResponse proto.InternalMessageInfo
+
+func (m *ReadCountsResponse) GetCounts() []*ReadCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *ReadCountsResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ReadCountsResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 分页拉取会话列表，按最后一条消息的时间倒序
+type ListConversationsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListConversationsRequest) Reset()         { *m = ListConversationsRequest{} }
+func (m *ListConversationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListConversationsRequest) ProtoMessage()    {}
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{41}
+}
+
+func (m *ListConversationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConversationsRequest.Unmarshal(m, b)
+}
+func (m *ListConversationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConversationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListConversationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConversationsRequest.Merge(m, src)
+}
+func (m *ListConversationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListConversationsRequest.Size(m)
+}
+func (m *ListConversationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConversationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListConversationsRequest proto.InternalMessageInfo
+
+func (m *ListConversationsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ListConversationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListConversationsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListConversationsRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ListConversationsRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type Conversation struct {
+	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	LastMsgId            int64    `protobuf:"varint,3,opt,name=last_msg_id,json=lastMsgId,proto3" json:"last_msg_id,omitempty"`
+	LastFrom             string   `protobuf:"bytes,4,opt,name=last_from,json=lastFrom,proto3" json:"last_from,omitempty"`
+	LastContent          string   `protobuf:"bytes,5,opt,name=last_content,json=lastContent,proto3" json:"last_content,omitempty"`
+	LastMsgType          int32    `protobuf:"varint,6,opt,name=last_msg_type,json=lastMsgType,proto3" json:"last_msg_type,omitempty"`
+	LastRecalled         bool     `protobuf:"varint,7,opt,name=last_recalled,json=lastRecalled,proto3" json:"last_recalled,omitempty"`
+	LastSeq              int64    `protobuf:"varint,8,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	LastTime             int64    `protobuf:"varint,9,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	Unread               int32    `protobuf:"varint,10,opt,name=unread,proto3" json:"unread,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Conversation) Reset()         { *m = Conversation{} }
+func (m *Conversation) String() string { return proto.CompactTextString(m) }
+func (*Conversation) ProtoMessage()    {}
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{42}
+}
+
+func (m *Conversation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Conversation.Unmarshal(m, b)
+}
+func (m *Conversation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Conversation.Marshal(b, m, deterministic)
+}
+func (m *Conversation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conversation.Merge(m, src)
+}
+func (m *Conversation) XXX_Size() int {
+	return xxx_messageInfo_Conversation.Size(m)
+}
+func (m *Conversation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conversation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Conversation proto.InternalMessageInfo
+
+func (m *Conversation) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *Conversation) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Conversation) GetLastMsgId() int64 {
+	if m != nil {
+		return m.LastMsgId
+	}
+	return 0
+}
+
+func (m *Conversation) GetLastFrom() string {
+	if m != nil {
+		return m.LastFrom
+	}
+	return ""
+}
+
+func (m *Conversation) GetLastContent() string {
+	if m != nil {
+		return m.LastContent
+	}
+	return ""
+}
+
+func (m *Conversation) GetLastMsgType() int32 {
+	if m != nil {
+		return m.LastMsgType
+	}
+	return 0
+}
+
+func (m *Conversation) GetLastRecalled() bool {
+	if m != nil {
+		return m.LastRecalled
+	}
+	return false
+}
+
+func (m *Conversation) GetLastSeq() int64 {
+	if m != nil {
+		return m.LastSeq
+	}
+	return 0
+}
+
+func (m *Conversation) GetLastTime() int64 {
+	if m != nil {
+		return m.LastTime
+	}
+	return 0
+}
+
+func (m *Conversation) GetUnread() int32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type ListConversationsResponse struct {
+	Conversations        []*Conversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	NextCursor           string          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Ts                   int64           `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64           `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListConversationsResponse) Reset()         { *m = ListConversationsResponse{} }
+func (m *ListConversationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConversationsResponse) ProtoMessage()    {}
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{43}
+}
+
+func (m *ListConversationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConversationsResponse.Unmarshal(m, b)
+}
+func (m *ListConversationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConversationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListConversationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConversationsResponse.Merge(m, src)
+}
+func (m *ListConversationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListConversationsResponse.Size(m)
+}
+func (m *ListConversationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConversationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListConversationsResponse proto.InternalMessageInfo
+
+func (m *ListConversationsResponse) GetConversations() []*Conversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+func (m *ListConversationsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *ListConversationsResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ListConversationsResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 清除会话的未读数
+type ClearUnreadRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Peer                 string   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearUnreadRequest) Reset()         { *m = ClearUnreadRequest{} }
+func (m *ClearUnreadRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearUnreadRequest) ProtoMessage()    {}
+func (*ClearUnreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{44}
+}
+
+func (m *ClearUnreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearUnreadRequest.Unmarshal(m, b)
+}
+func (m *ClearUnreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearUnreadRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearUnreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearUnreadRequest.Merge(m, src)
+}
+func (m *ClearUnreadRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearUnreadRequest.Size(m)
+}
+func (m *ClearUnreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearUnreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearUnreadRequest proto.InternalMessageInfo
+
+func (m *ClearUnreadRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ClearUnreadRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *ClearUnreadRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ClearUnreadRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ClearUnreadRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ClearUnreadResponse struct {
+	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearUnreadResponse) Reset()         { *m = ClearUnreadResponse{} }
+func (m *ClearUnreadResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearUnreadResponse) ProtoMessage()    {}
+func (*ClearUnreadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{45}
+}
+
+func (m *ClearUnreadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearUnreadResponse.Unmarshal(m, b)
+}
+func (m *ClearUnreadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearUnreadResponse.Marshal(b, m, deterministic)
+}
+func (m *ClearUnreadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearUnreadResponse.Merge(m, src)
+}
+func (m *ClearUnreadResponse) XXX_Size() int {
+	return xxx_messageInfo_ClearUnreadResponse.Size(m)
+}
+func (m *ClearUnreadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearUnreadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearUnreadResponse proto.InternalMessageInfo
+
+func (m *ClearUnreadResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ClearUnreadResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 分页查询会话的历史消息，不影响送达和已读状态
+type HistoryRequest struct {
+	Uid                  string                   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Peer                 string                   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Group                string                   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	CursorSeq            int64                    `protobuf:"varint,4,opt,name=cursor_seq,json=cursorSeq,proto3" json:"cursor_seq,omitempty"`
+	CursorMsgId          int64                    `protobuf:"varint,5,opt,name=cursor_msg_id,json=cursorMsgId,proto3" json:"cursor_msg_id,omitempty"`
+	Direction            HistoryRequest_Direction `protobuf:"varint,6,opt,name=direction,proto3,enum=protocol.HistoryRequest_Direction" json:"direction,omitempty"`
+	Limit                int32                    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Ts                   int64                    `protobuf:"varint,8,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64                    `protobuf:"varint,9,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{46}
+}
+
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryRequest.Unmarshal(m, b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_HistoryRequest.Size(m)
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
+
+func (m *HistoryRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetCursorSeq() int64 {
+	if m != nil {
+		return m.CursorSeq
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetCursorMsgId() int64 {
+	if m != nil {
+		return m.CursorMsgId
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetDirection() HistoryRequest_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return HistoryRequest_BACKWARD
+}
+
+func (m *HistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	Msg                  []*PullMsg `protobuf:"bytes,1,rep,name=msg,proto3" json:"msg,omitempty"`
+	HasMore              bool       `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursorSeq        int64      `protobuf:"varint,3,opt,name=next_cursor_seq,json=nextCursorSeq,proto3" json:"next_cursor_seq,omitempty"`
+	Ts                   int64      `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64      `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoryResponse) ProtoMessage()    {}
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{47}
+}
+
+func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryResponse.Unmarshal(m, b)
+}
+func (m *HistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *HistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryResponse.Merge(m, src)
+}
+func (m *HistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_HistoryResponse.Size(m)
+}
+func (m *HistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryResponse proto.InternalMessageInfo
+
+func (m *HistoryResponse) GetMsg() []*PullMsg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *HistoryResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *HistoryResponse) GetNextCursorSeq() int64 {
+	if m != nil {
+		return m.NextCursorSeq
+	}
+	return 0
+}
+
+func (m *HistoryResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *HistoryResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type PresenceDevice struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	DeviceId             string   `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PresenceDevice) Reset()         { *m = PresenceDevice{} }
+func (m *PresenceDevice) String() string { return proto.CompactTextString(m) }
+func (*PresenceDevice) ProtoMessage()    {}
+func (*PresenceDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{48}
+}
+
+func (m *PresenceDevice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PresenceDevice.Unmarshal(m, b)
+}
+func (m *PresenceDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PresenceDevice.Marshal(b, m, deterministic)
+}
+func (m *PresenceDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresenceDevice.Merge(m, src)
+}
+func (m *PresenceDevice) XXX_Size() int {
+	return xxx_messageInfo_PresenceDevice.Size(m)
+}
+func (m *PresenceDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresenceDevice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresenceDevice proto.InternalMessageInfo
+
+func (m *PresenceDevice) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PresenceDevice) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+// gate的心跳，刷新连接在该gate上的设备的在线状态，超过PresenceTTL没有刷新的设备视为离线
+type HeartbeatRequest struct {
+	Online               []*PresenceDevice `protobuf:"bytes,1,rep,name=online,proto3" json:"online,omitempty"`
+	Offline              []*PresenceDevice `protobuf:"bytes,2,rep,name=offline,proto3" json:"offline,omitempty"`
+	Ts                   int64             `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64             `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	GateId               string            `protobuf:"bytes,5,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
+func (m *HeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatRequest) ProtoMessage()    {}
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{49}
+}
+
+func (m *HeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeartbeatRequest.Unmarshal(m, b)
+}
+func (m *HeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeartbeatRequest.Marshal(b, m, deterministic)
+}
+func (m *HeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatRequest.Merge(m, src)
+}
+func (m *HeartbeatRequest) XXX_Size() int {
+	return xxx_messageInfo_HeartbeatRequest.Size(m)
+}
+func (m *HeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatRequest proto.InternalMessageInfo
+
+func (m *HeartbeatRequest) GetOnline() []*PresenceDevice {
+	if m != nil {
+		return m.Online
+	}
+	return nil
+}
+
+func (m *HeartbeatRequest) GetOffline() []*PresenceDevice {
+	if m != nil {
+		return m.Offline
+	}
+	return nil
+}
+
+func (m *HeartbeatRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *HeartbeatRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *HeartbeatRequest) GetGateId() string {
+	if m != nil {
+		return m.GateId
+	}
+	return ""
+}
+
+// 设置设备的在线状态
+type SetPresenceRequest struct {
+	Uid                  string        `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	DeviceId             string        `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	State                PresenceState `protobuf:"varint,3,opt,name=state,proto3,enum=protocol.PresenceState" json:"state,omitempty"`
+	Ts                   int64         `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64         `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	GateId               string        `protobuf:"bytes,6,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetPresenceRequest) Reset()         { *m = SetPresenceRequest{} }
+func (m *SetPresenceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPresenceRequest) ProtoMessage()    {}
+func (*SetPresenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{50}
+}
+
+func (m *SetPresenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPresenceRequest.Unmarshal(m, b)
+}
+func (m *SetPresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPresenceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPresenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPresenceRequest.Merge(m, src)
+}
+func (m *SetPresenceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPresenceRequest.Size(m)
+}
+func (m *SetPresenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPresenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPresenceRequest proto.InternalMessageInfo
+
+func (m *SetPresenceRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetPresenceRequest) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *SetPresenceRequest) GetState() PresenceState {
+	if m != nil {
+		return m.State
+	}
+	return PresenceState_OFFLINE
+}
+
+func (m *SetPresenceRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *SetPresenceRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *SetPresenceRequest) GetGateId() string {
+	if m != nil {
+		return m.GateId
+	}
+	return ""
+}
+
+type GetPresenceRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uids                 []string `protobuf:"bytes,2,rep,name=uids,proto3" json:"uids,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPresenceRequest) Reset()         { *m = GetPresenceRequest{} }
+func (m *GetPresenceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPresenceRequest) ProtoMessage()    {}
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{51}
+}
+
+func (m *GetPresenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPresenceRequest.Unmarshal(m, b)
+}
+func (m *GetPresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPresenceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPresenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPresenceRequest.Merge(m, src)
+}
+func (m *GetPresenceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPresenceRequest.Size(m)
+}
+func (m *GetPresenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPresenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPresenceRequest proto.InternalMessageInfo
+
+func (m *GetPresenceRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GetPresenceRequest) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+func (m *GetPresenceRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *GetPresenceRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 订阅用户的在线状态，状态变化时推送PresenceNotice，订阅一直有效直到取消
+type SubscribePresenceRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uids                 []string `protobuf:"bytes,2,rep,name=uids,proto3" json:"uids,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribePresenceRequest) Reset()         { *m = SubscribePresenceRequest{} }
+func (m *SubscribePresenceRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribePresenceRequest) ProtoMessage()    {}
+func (*SubscribePresenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{52}
+}
+
+func (m *SubscribePresenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribePresenceRequest.Unmarshal(m, b)
+}
+func (m *SubscribePresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribePresenceRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribePresenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePresenceRequest.Merge(m, src)
+}
+func (m *SubscribePresenceRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribePresenceRequest.Size(m)
+}
+func (m *SubscribePresenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePresenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePresenceRequest proto.InternalMessageInfo
+
+func (m *SubscribePresenceRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SubscribePresenceRequest) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+func (m *SubscribePresenceRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *SubscribePresenceRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type Presence struct {
+	Uid                  string        `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	State                PresenceState `protobuf:"varint,2,opt,name=state,proto3,enum=protocol.PresenceState" json:"state,omitempty"`
+	LastSeen             int64         `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Presence) Reset()         { *m = Presence{} }
+func (m *Presence) String() string { return proto.CompactTextString(m) }
+func (*Presence) ProtoMessage()    {}
+func (*Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{53}
+}
+
+func (m *Presence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Presence.Unmarshal(m, b)
+}
+func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+}
+func (m *Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presence.Merge(m, src)
+}
+func (m *Presence) XXX_Size() int {
+	return xxx_messageInfo_Presence.Size(m)
+}
+func (m *Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presence proto.InternalMessageInfo
+
+func (m *Presence) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Presence) GetState() PresenceState {
+	if m != nil {
+		return m.State
+	}
+	return PresenceState_OFFLINE
+}
+
+func (m *Presence) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+type PresenceResponse struct {
+	Status               int32       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Presences            []*Presence `protobuf:"bytes,3,rep,name=presences,proto3" json:"presences,omitempty"`
+	Ts                   int64       `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PresenceResponse) Reset()         { *m = PresenceResponse{} }
+func (m *PresenceResponse) String() string { return proto.CompactTextString(m) }
+func (*PresenceResponse) ProtoMessage()    {}
+func (*PresenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{54}
+}
+
+func (m *PresenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PresenceResponse.Unmarshal(m, b)
+}
+func (m *PresenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PresenceResponse.Marshal(b, m, deterministic)
+}
+func (m *PresenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresenceResponse.Merge(m, src)
+}
+func (m *PresenceResponse) XXX_Size() int {
+	return xxx_messageInfo_PresenceResponse.Size(m)
+}
+func (m *PresenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresenceResponse proto.InternalMessageInfo
+
+func (m *PresenceResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PresenceResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *PresenceResponse) GetPresences() []*Presence {
+	if m != nil {
+		return m.Presences
+	}
+	return nil
+}
+
+func (m *PresenceResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *PresenceResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 不保存的临时信号，例如正在输入，只推送给在线的接收者
+type SignalRequest struct {
+	From                 string             `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string             `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Group                string             `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Type                 SignalRequest_Type `protobuf:"varint,4,opt,name=type,proto3,enum=protocol.SignalRequest_Type" json:"type,omitempty"`
+	Data                 string             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Ts                   int64              `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64              `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SignalRequest) Reset()         { *m = SignalRequest{} }
+func (m *SignalRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalRequest) ProtoMessage()    {}
+func (*SignalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{55}
+}
+
+func (m *SignalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalRequest.Unmarshal(m, b)
+}
+func (m *SignalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalRequest.Marshal(b, m, deterministic)
+}
+func (m *SignalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalRequest.Merge(m, src)
+}
+func (m *SignalRequest) XXX_Size() int {
+	return xxx_messageInfo_SignalRequest.Size(m)
+}
+func (m *SignalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalRequest proto.InternalMessageInfo
+
+func (m *SignalRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetType() SignalRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return SignalRequest_TYPING
+}
+
+func (m *SignalRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *SignalRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *SignalRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type SignalResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalResponse) Reset()         { *m = SignalResponse{} }
+func (m *SignalResponse) String() string { return proto.CompactTextString(m) }
+func (*SignalResponse) ProtoMessage()    {}
+func (*SignalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60207fea82c31ca8, []int{56}
+}
+
+func (m *SignalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalResponse.Unmarshal(m, b)
+}
+func (m *SignalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalResponse.Marshal(b, m, deterministic)
+}
+func (m *SignalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalResponse.Merge(m, src)
+}
+func (m *SignalResponse) XXX_Size() int {
+	return xxx_messageInfo_SignalResponse.Size(m)
+}
+func (m *SignalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalResponse proto.InternalMessageInfo
+
+func (m *SignalResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *SignalResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *SignalResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *SignalResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("protocol.ContentType", ContentType_name, ContentType_value)
+	proto.RegisterEnum("protocol.GroupRole", GroupRole_name, GroupRole_value)
+	proto.RegisterEnum("protocol.PresenceState", PresenceState_name, PresenceState_value)
+	proto.RegisterEnum("protocol.AuthResponse_Status", AuthResponse_Status_name, AuthResponse_Status_value)
+	proto.RegisterEnum("protocol.C2CSendResponse_Status", C2CSendResponse_Status_name, C2CSendResponse_Status_value)
+	proto.RegisterEnum("protocol.C2GSendResponse_Status", C2GSendResponse_Status_name, C2GSendResponse_Status_value)
+	proto.RegisterEnum("protocol.GroupResponse_Status", GroupResponse_Status_name, GroupResponse_Status_value)
+	proto.RegisterEnum("protocol.RecallResponse_Status", RecallResponse_Status_name, RecallResponse_Status_value)
+	proto.RegisterEnum("protocol.HistoryRequest_Direction", HistoryRequest_Direction_name, HistoryRequest_Direction_value)
+	proto.RegisterEnum("protocol.PresenceResponse_Status", PresenceResponse_Status_name, PresenceResponse_Status_value)
+	proto.RegisterEnum("protocol.SignalRequest_Type", SignalRequest_Type_name, SignalRequest_Type_value)
+	proto.RegisterEnum("protocol.SignalResponse_Status", SignalResponse_Status_name, SignalResponse_Status_value)
+	proto.RegisterType((*Response)(nil), "protocol.Response")
+	proto.RegisterType((*Payload)(nil), "protocol.Payload")
+	proto.RegisterType((*TextPayload)(nil), "protocol.TextPayload")
+	proto.RegisterType((*ImagePayload)(nil), "protocol.ImagePayload")
+	proto.RegisterType((*FilePayload)(nil), "protocol.FilePayload")
+	proto.RegisterType((*LocationPayload)(nil), "protocol.LocationPayload")
+	proto.RegisterType((*CustomPayload)(nil), "protocol.CustomPayload")
+	proto.RegisterType((*AuthRequest)(nil), "protocol.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "protocol.AuthResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "protocol.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "protocol.LogoutResponse")
+	proto.RegisterType((*C2CSendRequest)(nil), "protocol.C2CSendRequest")
+	proto.RegisterType((*C2CSendResponse)(nil), "protocol.C2CSendResponse")
+	proto.RegisterType((*C2CPushResponse)(nil), "protocol.C2CPushResponse")
+	proto.RegisterType((*C2GSendRequest)(nil), "protocol.C2GSendRequest")
+	proto.RegisterType((*C2GSendResponse)(nil), "protocol.C2GSendResponse")
+	proto.RegisterType((*C2GPushResponse)(nil), "protocol.C2GPushResponse")
+	proto.RegisterType((*C2SPullMessageRequest)(nil), "protocol.C2SPullMessageRequest")
+	proto.RegisterType((*PullMsg)(nil), "protocol.PullMsg")
+	proto.RegisterType((*C2SPullMessageResponse)(nil), "protocol.C2SPullMessageResponse")
+	proto.RegisterType((*PullBySeqRequest)(nil), "protocol.PullBySeqRequest")
+	proto.RegisterType((*GroupResponse)(nil), "protocol.GroupResponse")
+	proto.RegisterType((*CreateGroupRequest)(nil), "protocol.CreateGroupRequest")
+	proto.RegisterType((*RenameGroupRequest)(nil), "protocol.RenameGroupRequest")
+	proto.RegisterType((*GroupRequest)(nil), "protocol.GroupRequest")
+	proto.RegisterType((*GroupMembersRequest)(nil), "protocol.GroupMembersRequest")
+	proto.RegisterType((*GroupMember)(nil), "protocol.GroupMember")
+	proto.RegisterType((*ListGroupMembersResponse)(nil), "protocol.ListGroupMembersResponse")
+	proto.RegisterType((*ListUserGroupsRequest)(nil), "protocol.ListUserGroupsRequest")
+	proto.RegisterType((*GroupInfo)(nil), "protocol.GroupInfo")
+	proto.RegisterType((*ListUserGroupsResponse)(nil), "protocol.ListUserGroupsResponse")
+	proto.RegisterType((*MuteGroupRequest)(nil), "protocol.MuteGroupRequest")
+	proto.RegisterType((*SetGroupAdminRequest)(nil), "protocol.SetGroupAdminRequest")
+	proto.RegisterType((*TransferGroupOwnerRequest)(nil), "protocol.TransferGroupOwnerRequest")
+	proto.RegisterType((*RecallRequest)(nil), "protocol.RecallRequest")
+	proto.RegisterType((*RecallResponse)(nil), "protocol.RecallResponse")
+	proto.RegisterType((*MarkReadRequest)(nil), "protocol.MarkReadRequest")
+	proto.RegisterType((*MarkReadResponse)(nil), "protocol.MarkReadResponse")
+	proto.RegisterType((*ReadCountsRequest)(nil), "protocol.ReadCountsRequest")
+	proto.RegisterType((*ReadCount)(nil), "protocol.ReadCount")
+	proto.RegisterType((*ReadCountsResponse)(nil), "protocol.ReadCountsResponse")
+	proto.RegisterType((*ListConversationsRequest)(nil), "protocol.ListConversationsRequest")
+	proto.RegisterType((*Conversation)(nil), "protocol.Conversation")
+	proto.RegisterType((*ListConversationsResponse)(nil), "protocol.ListConversationsResponse")
+	proto.RegisterType((*ClearUnreadRequest)(nil), "protocol.ClearUnreadRequest")
+	proto.RegisterType((*ClearUnreadResponse)(nil), "protocol.ClearUnreadResponse")
+	proto.RegisterType((*HistoryRequest)(nil), "protocol.HistoryRequest")
+	proto.RegisterType((*HistoryResponse)(nil), "protocol.HistoryResponse")
+	proto.RegisterType((*PresenceDevice)(nil), "protocol.PresenceDevice")
+	proto.RegisterType((*HeartbeatRequest)(nil), "protocol.HeartbeatRequest")
+	proto.RegisterType((*SetPresenceRequest)(nil), "protocol.SetPresenceRequest")
+	proto.RegisterType((*GetPresenceRequest)(nil), "protocol.GetPresenceRequest")
+	proto.RegisterType((*SubscribePresenceRequest)(nil), "protocol.SubscribePresenceRequest")
+	proto.RegisterType((*Presence)(nil), "protocol.Presence")
+	proto.RegisterType((*PresenceResponse)(nil), "protocol.PresenceResponse")
+	proto.RegisterType((*SignalRequest)(nil), "protocol.SignalRequest")
+	proto.RegisterType((*SignalResponse)(nil), "protocol.SignalResponse")
+}
+
+func init() { proto.RegisterFile("logic.proto", fileDescriptor_60207fea82c31ca8) }
+
+var fileDescriptor_60207fea82c31ca8 = []byte{
+	// 2905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x4b, 0x8f, 0xe3, 0xc6,
+	0xd1, 0x4b, 0x4a, 0xa2, 0xa4, 0xd2, 0x68, 0x86, 0xd3, 0xbb, 0x3b, 0xab, 0x91, 0xbd, 0xf6, 0x9a,
+	0x0b, 0xf8, 0x33, 0xd6, 0x9f, 0xf7, 0xf3, 0x37, 0x41, 0xe0, 0x00, 0x76, 0x62, 0x6b, 0x25, 0x8d,
+	0x56, 0xb6, 0x1e, 0x03, 0x52, 0xca, 0x7a, 0x03, 0x03, 0x0a, 0x47, 0xea, 0xd1, 0x10, 0xa6, 0xc8,
+	0x59, 0x92, 0x1a, 0xef, 0xe4, 0x71, 0xf0, 0x29, 0x40, 0x80, 0xe4, 0x9a, 0x43, 0x72, 0x0e, 0x90,
+	0x43, 0x0e, 0x46, 0x80, 0x20, 0x40, 0x92, 0xbf, 0x91, 0x7f, 0x90, 0x4b, 0x10, 0xe4, 0x0f, 0xe4,
+	0x12, 0xf4, 0x83, 0x64, 0x53, 0xa2, 0x46, 0x9a, 0xf1, 0x6e, 0x10, 0xe4, 0x24, 0x76, 0x77, 0x55,
+	0x75, 0xbd, 0xba, 0xba, 0xaa, 0x5a, 0x50, 0xb2, 0xdd, 0xa9, 0x35, 0x7e, 0x78, 0xe6, 0xb9, 0x81,
+	0x8b, 0x0a, 0xf4, 0x67, 0xec, 0xda, 0xda, 0xff, 0x42, 0x41, 0xc7, 0xfe, 0x99, 0xeb, 0xf8, 0x18,
+	0x6d, 0x83, 0x1c, 0xf8, 0x15, 0xe9, 0x9e, 0xf4, 0x56, 0x46, 0x97, 0x03, 0x1f, 0xa9, 0x90, 0xf1,
+	0xf1, 0xb3, 0x8a, 0x4c, 0x27, 0xc8, 0xa7, 0xf6, 0x13, 0x19, 0xf2, 0x47, 0xe6, 0x85, 0xed, 0x9a,
+	0x13, 0xf4, 0x36, 0x64, 0x03, 0xfc, 0x3c, 0xa0, 0xf0, 0xa5, 0x83, 0xdb, 0x0f, 0x43, 0x92, 0x0f,
+	0x07, 0xf8, 0x79, 0xc0, 0x81, 0x1e, 0xdf, 0xd0, 0x29, 0x10, 0x7a, 0x08, 0x39, 0x6b, 0x66, 0x4e,
+	0x31, 0x25, 0x56, 0x3a, 0xd8, 0x8b, 0xa1, 0xdb, 0x64, 0x3a, 0x06, 0x67, 0x60, 0x84, 0xf8, 0x89,
+	0x65, 0xe3, 0x4a, 0x66, 0x91, 0xf8, 0xa1, 0x65, 0x0b, 0xd0, 0x14, 0x08, 0xbd, 0x07, 0x05, 0xdb,
+	0x1d, 0x9b, 0x81, 0xe5, 0x3a, 0x95, 0x2c, 0x45, 0xd8, 0x8f, 0x11, 0x3a, 0x7c, 0x25, 0x46, 0x8a,
+	0x80, 0xd1, 0xff, 0x83, 0x32, 0x9e, 0xfb, 0x81, 0x3b, 0xab, 0xe4, 0x28, 0xda, 0x9d, 0x18, 0xad,
+	0x4e, 0xe7, 0x63, 0x24, 0x0e, 0xf8, 0x48, 0x81, 0xec, 0xb1, 0x3b, 0xb9, 0xd0, 0xde, 0x80, 0x92,
+	0x20, 0x27, 0x42, 0x82, 0x32, 0x8a, 0x4c, 0x66, 0xed, 0xcf, 0x12, 0x6c, 0x89, 0xd2, 0x11, 0x7d,
+	0xce, 0x3d, 0x9b, 0xc3, 0x90, 0x4f, 0x74, 0x1f, 0xca, 0xc1, 0xe9, 0x7c, 0x76, 0xec, 0x98, 0x96,
+	0x3d, 0x22, 0x6b, 0x32, 0x5d, 0xdb, 0x8a, 0x26, 0x87, 0x9e, 0x8d, 0x6e, 0x41, 0xee, 0x0b, 0x6b,
+	0x12, 0x9c, 0x52, 0x65, 0xe4, 0x74, 0x36, 0x40, 0x7b, 0xa0, 0x9c, 0x62, 0x6b, 0x7a, 0x1a, 0x50,
+	0x91, 0x73, 0x3a, 0x1f, 0x11, 0x4e, 0x7c, 0xeb, 0x07, 0x98, 0x4a, 0x94, 0xd1, 0xe9, 0x37, 0x7a,
+	0x05, 0x8a, 0x33, 0x6b, 0x86, 0x47, 0xc1, 0xc5, 0x19, 0xae, 0x28, 0x74, 0x8b, 0x02, 0x99, 0x18,
+	0x5c, 0x9c, 0x61, 0x74, 0x07, 0xf2, 0xc7, 0xb6, 0x7b, 0x3c, 0xb2, 0x26, 0x95, 0x3c, 0x5d, 0x52,
+	0xc8, 0xb0, 0x3d, 0xd1, 0x7e, 0x0c, 0x25, 0x41, 0xdb, 0x29, 0xdc, 0x23, 0xc8, 0x3a, 0xe6, 0x0c,
+	0x73, 0xa6, 0xe9, 0x77, 0xb4, 0x7d, 0x66, 0xd5, 0xf6, 0xd9, 0xd5, 0xdb, 0xe7, 0x12, 0xdb, 0x63,
+	0xd8, 0x59, 0xb0, 0x1d, 0xaa, 0x42, 0xc1, 0x36, 0x03, 0x2b, 0x98, 0x4f, 0x30, 0xe5, 0x43, 0xd2,
+	0xa3, 0x31, 0x7a, 0x15, 0x8a, 0xb6, 0xeb, 0x4c, 0xd9, 0xa2, 0x4c, 0x17, 0xe3, 0x09, 0x54, 0x81,
+	0xbc, 0x39, 0x99, 0x78, 0xd8, 0xf7, 0x29, 0x67, 0x45, 0x3d, 0x1c, 0x6a, 0xef, 0x41, 0x39, 0x61,
+	0x6b, 0x6a, 0xca, 0x8b, 0x33, 0xb6, 0x01, 0x31, 0x25, 0x61, 0x12, 0x41, 0x76, 0x62, 0x06, 0x66,
+	0x28, 0x29, 0xf9, 0xd6, 0x7e, 0x23, 0x41, 0xa9, 0x36, 0x0f, 0x4e, 0x75, 0xfc, 0x6c, 0x8e, 0xfd,
+	0x80, 0x98, 0x29, 0x70, 0x3f, 0xc7, 0x0e, 0x47, 0x64, 0x03, 0xaa, 0x35, 0x6b, 0xc2, 0x11, 0xc9,
+	0x27, 0x3f, 0x65, 0x99, 0xc5, 0x53, 0x96, 0x8d, 0x4e, 0x19, 0xd1, 0xd7, 0x04, 0x9f, 0x5b, 0x63,
+	0x1c, 0x2b, 0xa5, 0xc0, 0x26, 0xda, 0x54, 0x07, 0x67, 0xb6, 0x19, 0x9c, 0xb8, 0xde, 0x2c, 0x34,
+	0x65, 0x38, 0x26, 0xba, 0x9c, 0x9a, 0x01, 0x16, 0x4c, 0x49, 0x86, 0xed, 0x89, 0xf6, 0x27, 0x09,
+	0xb6, 0x18, 0xaf, 0xfc, 0xa8, 0xef, 0x81, 0xe2, 0x07, 0x66, 0x30, 0x67, 0xc7, 0x3d, 0xa7, 0xf3,
+	0x11, 0x61, 0x66, 0xe6, 0x4f, 0x43, 0x76, 0x67, 0xfe, 0x74, 0x3d, 0xbb, 0xda, 0xf7, 0x41, 0x31,
+	0x18, 0x76, 0x09, 0xf2, 0xc6, 0xb0, 0x5e, 0x6f, 0x1a, 0x86, 0x7a, 0x03, 0xa9, 0xb0, 0x35, 0x34,
+	0x9a, 0xfa, 0xa8, 0xdd, 0xfb, 0x6e, 0xad, 0xd3, 0x6e, 0xa8, 0x12, 0xda, 0x85, 0xf2, 0xa0, 0xff,
+	0x49, 0xb3, 0x37, 0x6a, 0x7e, 0x7a, 0xd4, 0xd6, 0x9b, 0x0d, 0x55, 0x26, 0x53, 0x8f, 0x6a, 0x8d,
+	0x91, 0xd1, 0x6e, 0xf5, 0x6a, 0x83, 0xa1, 0xde, 0x54, 0x33, 0x31, 0x54, 0x88, 0x98, 0xd5, 0xce,
+	0xa1, 0xdc, 0x71, 0xa7, 0xee, 0x3c, 0xf8, 0xf7, 0xea, 0x5a, 0x3b, 0x80, 0xed, 0x70, 0xdf, 0x8d,
+	0x43, 0xe4, 0x3f, 0x25, 0xd8, 0xae, 0x1f, 0xd4, 0x0d, 0xec, 0x4c, 0x42, 0x6e, 0x11, 0x64, 0x4f,
+	0x3c, 0x77, 0x16, 0x7a, 0x14, 0xf9, 0xa6, 0x84, 0x5c, 0xce, 0xaa, 0x1c, 0xb8, 0xc4, 0x41, 0xc7,
+	0xae, 0x13, 0x60, 0x27, 0x08, 0x1d, 0x94, 0x0f, 0xf9, 0x96, 0xd9, 0xc5, 0x2d, 0x73, 0xb1, 0x0c,
+	0x1a, 0x94, 0xc7, 0xb6, 0x85, 0x9d, 0x60, 0x34, 0xf3, 0xa7, 0x44, 0x0e, 0xe6, 0x17, 0x25, 0x36,
+	0xd9, 0xf5, 0xa7, 0xed, 0x09, 0xfa, 0x16, 0x6c, 0x71, 0x82, 0xec, 0x18, 0x12, 0xff, 0xd8, 0x16,
+	0x03, 0x6b, 0x9d, 0xad, 0x92, 0x33, 0xa9, 0x97, 0xc6, 0xf1, 0x00, 0xbd, 0x0d, 0xf9, 0x33, 0x76,
+	0x34, 0x2a, 0x05, 0x1a, 0x25, 0x77, 0x63, 0x24, 0x7e, 0x66, 0xf4, 0x10, 0x42, 0xfb, 0xa3, 0x04,
+	0x3b, 0x91, 0xf4, 0x5c, 0x67, 0xb7, 0x41, 0xe1, 0x7c, 0x31, 0xbd, 0xe5, 0x66, 0x94, 0x23, 0x26,
+	0x97, 0xbc, 0x28, 0x57, 0x26, 0x96, 0x6b, 0x1f, 0x0a, 0x63, 0xd7, 0x39, 0x1f, 0xc5, 0x26, 0x23,
+	0x4a, 0x39, 0x37, 0xf0, 0x33, 0xc1, 0x7f, 0x73, 0x69, 0xfe, 0xab, 0x44, 0xfe, 0xab, 0x3d, 0x48,
+	0xf7, 0xce, 0x9b, 0xb0, 0xc3, 0xfd, 0x6b, 0x74, 0x54, 0x7b, 0xda, 0xe9, 0xd7, 0x1a, 0xaa, 0xa4,
+	0x7d, 0x46, 0x99, 0x3f, 0x9a, 0xfb, 0xa7, 0x5f, 0x9f, 0x79, 0xee, 0x8c, 0xd9, 0xc8, 0x19, 0xb5,
+	0x2f, 0x65, 0xe2, 0x19, 0xad, 0x75, 0x9e, 0x71, 0x0b, 0x72, 0x53, 0xcf, 0x9d, 0x9f, 0x71, 0xe7,
+	0x60, 0x83, 0xff, 0x16, 0xff, 0xf8, 0x0b, 0xf5, 0x8f, 0xd6, 0x7f, 0x90, 0x7f, 0x34, 0xd3, 0xfd,
+	0x63, 0x1b, 0xa0, 0xd7, 0x1f, 0x8c, 0xba, 0xcd, 0xee, 0xa3, 0xa6, 0xae, 0x4a, 0xa8, 0x08, 0xb9,
+	0xee, 0x70, 0x40, 0x63, 0x56, 0x8a, 0xeb, 0x64, 0x98, 0xeb, 0xb4, 0x5e, 0x96, 0xeb, 0x3c, 0x87,
+	0xdb, 0xf5, 0x03, 0xe3, 0x68, 0x6e, 0xdb, 0x5d, 0xec, 0xfb, 0xe6, 0x14, 0x87, 0x0e, 0xc4, 0x41,
+	0xa5, 0x08, 0x54, 0xd8, 0x55, 0x16, 0x77, 0xbd, 0x05, 0x39, 0xdb, 0x9a, 0x59, 0x41, 0x98, 0x44,
+	0xd0, 0xc1, 0x7a, 0xdf, 0xd1, 0xfe, 0x4e, 0x32, 0x3e, 0xb2, 0xaf, 0x3f, 0x7d, 0x21, 0xde, 0x1a,
+	0xb3, 0x97, 0x15, 0xd9, 0x7b, 0x05, 0x8a, 0x3e, 0x76, 0x26, 0xa3, 0xc0, 0x9a, 0x85, 0xa9, 0x4b,
+	0x81, 0x4c, 0x0c, 0xac, 0x59, 0x18, 0x74, 0x95, 0x45, 0x2e, 0xf3, 0xe9, 0x9e, 0x50, 0x48, 0x7a,
+	0x02, 0x0b, 0xb4, 0xc5, 0x28, 0xd0, 0xee, 0x43, 0x81, 0x30, 0x40, 0x9d, 0x1c, 0xa8, 0x2e, 0xf2,
+	0x33, 0x7f, 0x4a, 0x3d, 0xb9, 0x0a, 0x05, 0x0f, 0x8f, 0x4d, 0xdb, 0xc6, 0x93, 0x4a, 0xe9, 0x9e,
+	0xf4, 0x56, 0x41, 0x8f, 0xc6, 0x4b, 0xe7, 0x63, 0xeb, 0x3a, 0xe7, 0xa3, 0xbc, 0xf6, 0x7c, 0x8c,
+	0x60, 0x6f, 0xd1, 0xd0, 0xdc, 0x9b, 0xee, 0x33, 0xcf, 0x95, 0xee, 0x65, 0x16, 0x48, 0x30, 0xe3,
+	0x88, 0x97, 0xf5, 0x25, 0xbe, 0xa5, 0xfd, 0x41, 0x02, 0x95, 0xa0, 0x3c, 0xba, 0x30, 0xf0, 0xb3,
+	0xd5, 0x5e, 0x84, 0x20, 0x7b, 0x86, 0xb1, 0x17, 0x26, 0x3c, 0xe4, 0x3b, 0x36, 0x75, 0x46, 0x34,
+	0xf5, 0x3e, 0x14, 0x88, 0x23, 0x88, 0x87, 0x90, 0x8c, 0x89, 0xea, 0x6f, 0x83, 0x12, 0xb8, 0xa3,
+	0xd8, 0xa1, 0x72, 0x81, 0x4b, 0xa6, 0x23, 0x57, 0x54, 0x96, 0x5d, 0x31, 0xbf, 0xc8, 0x7a, 0x21,
+	0x66, 0xfd, 0x4b, 0x19, 0xca, 0x2d, 0xb2, 0xe7, 0x35, 0xb2, 0x98, 0x74, 0xde, 0xd7, 0xbb, 0xff,
+	0xcf, 0xa4, 0x95, 0xd7, 0x47, 0x4b, 0xef, 0x0f, 0x8f, 0x46, 0x24, 0x48, 0x1c, 0xf6, 0x87, 0x3d,
+	0x92, 0xdf, 0x24, 0x63, 0x86, 0x8c, 0x10, 0x6c, 0xd7, 0x3a, 0x7a, 0xb3, 0xd6, 0x78, 0x1a, 0xce,
+	0x65, 0x96, 0xb2, 0xa2, 0x2c, 0xba, 0x05, 0x2a, 0x1f, 0x8c, 0x6a, 0x7a, 0x6b, 0xd8, 0x6d, 0xf6,
+	0x06, 0x6a, 0x0e, 0xdd, 0x86, 0xdd, 0xa3, 0xa6, 0xde, 0x6d, 0x1b, 0x46, 0xbb, 0xdf, 0x1b, 0x35,
+	0x9a, 0xbd, 0x76, 0xb3, 0xa1, 0x2a, 0xda, 0x4f, 0x25, 0x40, 0x75, 0x0f, 0x9b, 0x01, 0xe6, 0x9a,
+	0xb8, 0xc4, 0x80, 0x4b, 0xb9, 0x79, 0x05, 0xf2, 0x33, 0x3c, 0x3b, 0xc6, 0x1e, 0x49, 0x89, 0x32,
+	0xe4, 0x54, 0xf2, 0xe1, 0x06, 0x77, 0x08, 0x82, 0xac, 0x7b, 0x86, 0x1d, 0x6a, 0xb3, 0x82, 0x4e,
+	0xbf, 0x35, 0x0f, 0x90, 0x8e, 0x09, 0xe5, 0x35, 0xbc, 0xa4, 0xc7, 0x88, 0x90, 0xc3, 0x8c, 0xc0,
+	0xe1, 0x7a, 0x83, 0x7c, 0x0a, 0x5b, 0xd7, 0xda, 0x6d, 0x7d, 0x1a, 0x7b, 0x01, 0x37, 0x29, 0xe5,
+	0x2e, 0xd3, 0xc9, 0x55, 0x37, 0xf8, 0x1a, 0xca, 0xd5, 0x1e, 0x43, 0x49, 0xd8, 0x3a, 0x65, 0xcb,
+	0xff, 0x81, 0xac, 0xe7, 0xda, 0xcc, 0x9a, 0xdb, 0x07, 0x37, 0xe3, 0xd3, 0xcf, 0x74, 0xe1, 0xda,
+	0x58, 0xa7, 0x00, 0xda, 0x2f, 0x24, 0xa8, 0x74, 0x2c, 0x3f, 0x48, 0x4a, 0x72, 0xe5, 0xe3, 0xf2,
+	0x7f, 0x49, 0x61, 0x12, 0x15, 0xb8, 0x40, 0xfa, 0x2a, 0x32, 0x7e, 0x02, 0xb7, 0x09, 0x63, 0x43,
+	0x1f, 0x7b, 0x94, 0xc2, 0x25, 0x0a, 0x5e, 0x1f, 0xc5, 0x02, 0x28, 0x52, 0x22, 0x6d, 0xe7, 0xc4,
+	0x8d, 0xed, 0x21, 0xa5, 0xb9, 0x97, 0x78, 0x00, 0x42, 0x35, 0x66, 0xd6, 0xa8, 0x91, 0x90, 0x9c,
+	0xcd, 0x03, 0xcc, 0x2e, 0xa9, 0x82, 0xce, 0x06, 0xda, 0x14, 0xf6, 0x16, 0x45, 0xe0, 0x9a, 0x7d,
+	0x1b, 0x14, 0xba, 0xab, 0xcf, 0xe3, 0xf3, 0x22, 0x69, 0xc2, 0xa7, 0xce, 0x41, 0x36, 0x10, 0xcf,
+	0x03, 0xb5, 0x3b, 0x0f, 0xae, 0x77, 0xac, 0x22, 0xd6, 0x33, 0x02, 0xeb, 0x1b, 0xd8, 0xe7, 0xe7,
+	0x12, 0xdc, 0x32, 0x30, 0x73, 0x9c, 0xda, 0x64, 0x66, 0x39, 0x57, 0xdd, 0x78, 0x0f, 0x14, 0xe6,
+	0x0d, 0xfc, 0x44, 0xf3, 0x11, 0x81, 0x36, 0x09, 0xbd, 0x50, 0x97, 0x74, 0xc0, 0x19, 0xca, 0x2d,
+	0x32, 0xa4, 0xc4, 0x0c, 0xfd, 0x10, 0xf6, 0x07, 0x9e, 0xe9, 0xf8, 0x27, 0x5c, 0xdb, 0xfd, 0x2f,
+	0x1c, 0xec, 0x5d, 0x43, 0x1b, 0xee, 0x17, 0x4e, 0xc4, 0x13, 0x1b, 0x6c, 0xa0, 0x8d, 0xef, 0x41,
+	0x59, 0xa7, 0x57, 0xff, 0x95, 0x13, 0xad, 0xf5, 0x81, 0xe6, 0xd7, 0x12, 0x6c, 0x87, 0xc4, 0x5f,
+	0x42, 0x39, 0xfe, 0x38, 0xfd, 0xc6, 0x2a, 0x43, 0x51, 0xbc, 0xab, 0x52, 0xef, 0x17, 0x99, 0xa0,
+	0x84, 0xc5, 0x79, 0x86, 0x5c, 0x36, 0x3b, 0x5d, 0xd3, 0xfb, 0x5c, 0xc7, 0xe6, 0xe4, 0x05, 0xa5,
+	0x0a, 0xab, 0xf2, 0xf5, 0xf5, 0xee, 0xf0, 0x31, 0xa8, 0x31, 0x2f, 0x5c, 0x6d, 0xb7, 0x20, 0x37,
+	0x76, 0xe7, 0x4e, 0xc0, 0xb5, 0xc6, 0x06, 0x1b, 0x9c, 0xaf, 0x73, 0xd8, 0x25, 0x74, 0xea, 0x04,
+	0xfc, 0xca, 0x81, 0xfe, 0x0e, 0xe4, 0x99, 0xdd, 0x59, 0x6c, 0xcc, 0xe8, 0x0a, 0x35, 0xfc, 0x26,
+	0x31, 0xf0, 0x09, 0x14, 0xa3, 0x7d, 0x57, 0x95, 0x07, 0x77, 0x01, 0x3c, 0x6c, 0x4e, 0x46, 0x4c,
+	0x30, 0x99, 0x0a, 0x56, 0xf4, 0x22, 0x2c, 0xda, 0xf9, 0x08, 0x4c, 0x3b, 0xcc, 0xe3, 0xe9, 0x40,
+	0x1b, 0x93, 0x9b, 0x38, 0x16, 0x28, 0x8e, 0x4a, 0x94, 0x4a, 0x4a, 0x54, 0x8a, 0xa0, 0x75, 0x0e,
+	0xb2, 0x81, 0xd6, 0x7e, 0xc4, 0xae, 0x96, 0xba, 0xeb, 0x9c, 0x63, 0xcf, 0xa7, 0x8d, 0xb9, 0x4b,
+	0x94, 0xb7, 0x47, 0x7a, 0xab, 0x9e, 0xef, 0x86, 0x8e, 0xc1, 0x47, 0xd7, 0x2e, 0x44, 0xbe, 0x92,
+	0x61, 0x4b, 0xdc, 0x3a, 0xf2, 0x3b, 0x29, 0xcd, 0xef, 0x12, 0x16, 0x7b, 0x0d, 0x4a, 0xb6, 0xe9,
+	0x47, 0xd5, 0x2f, 0x13, 0xa9, 0x48, 0xa6, 0xba, 0x61, 0xf1, 0x41, 0xd7, 0x69, 0x71, 0xc3, 0xfb,
+	0x93, 0x64, 0xe2, 0x90, 0x14, 0x38, 0x6f, 0xc0, 0x16, 0x5d, 0x0c, 0xeb, 0x19, 0xd6, 0x23, 0xa2,
+	0x04, 0x79, 0xca, 0x4f, 0xea, 0xeb, 0x88, 0x7e, 0xd4, 0x62, 0xcd, 0x31, 0x98, 0x2e, 0xaf, 0x2d,
+	0xee, 0x73, 0x98, 0xa8, 0xc0, 0xc8, 0xd3, 0x68, 0x48, 0x69, 0xeb, 0x7c, 0x8e, 0x1c, 0x10, 0x0a,
+	0x24, 0x94, 0x31, 0x64, 0x6c, 0xb0, 0x3e, 0x15, 0x5d, 0xa2, 0x05, 0x52, 0x91, 0x15, 0x48, 0x64,
+	0x82, 0x16, 0x48, 0x7b, 0xa0, 0xcc, 0x1d, 0xe2, 0x23, 0xbc, 0xa2, 0xe1, 0x23, 0xed, 0x57, 0x12,
+	0xec, 0xa7, 0x98, 0x8c, 0xbb, 0xc7, 0x07, 0x50, 0x1e, 0x8b, 0x0b, 0xdc, 0x4b, 0xf6, 0x12, 0x35,
+	0x4d, 0xb4, 0xac, 0x27, 0x81, 0xd1, 0xeb, 0x50, 0x72, 0xf0, 0xf3, 0x60, 0x94, 0x30, 0x32, 0x90,
+	0xa9, 0x3a, 0x33, 0xf4, 0xfa, 0x48, 0xe5, 0x01, 0xaa, 0xdb, 0xd8, 0xf4, 0x86, 0x94, 0xdb, 0x17,
+	0x11, 0x61, 0xd6, 0xbb, 0xd1, 0x7b, 0x70, 0x33, 0xb1, 0xe7, 0xc6, 0x7d, 0xbd, 0xaf, 0x64, 0xd8,
+	0x7e, 0x6c, 0xf9, 0x81, 0xeb, 0x5d, 0xbc, 0x08, 0x4e, 0xef, 0x02, 0x30, 0xcd, 0x09, 0xd1, 0xb0,
+	0xc8, 0x66, 0x0c, 0xde, 0xb2, 0x61, 0xcb, 0xdc, 0x69, 0x99, 0x08, 0x25, 0x36, 0xc9, 0xdc, 0xf6,
+	0x23, 0x28, 0x4e, 0x2c, 0x0f, 0x8f, 0xe9, 0xbb, 0x87, 0x42, 0x53, 0x1a, 0x2d, 0xb6, 0x5d, 0x92,
+	0xd7, 0x87, 0x8d, 0x10, 0x52, 0x8f, 0x91, 0xe2, 0xb3, 0x98, 0x5f, 0x3e, 0x8b, 0x85, 0x45, 0x5d,
+	0x14, 0x63, 0x5d, 0xbc, 0x09, 0xc5, 0x88, 0x1e, 0xda, 0x82, 0xc2, 0xa3, 0x5a, 0xfd, 0x93, 0x27,
+	0x35, 0xbd, 0xa1, 0xde, 0x20, 0x17, 0xc8, 0x61, 0x5f, 0xa7, 0x03, 0x49, 0xfb, 0xa5, 0x04, 0x3b,
+	0x11, 0x1f, 0x57, 0xa9, 0x63, 0xf7, 0xa1, 0x70, 0x6a, 0xfa, 0xa3, 0x99, 0xeb, 0xb1, 0x04, 0xae,
+	0xa0, 0xe7, 0x4f, 0x4d, 0xbf, 0xeb, 0x7a, 0x18, 0xbd, 0x09, 0x3b, 0x82, 0xdf, 0x8d, 0xe2, 0x18,
+	0x55, 0x8e, 0x7d, 0x2f, 0xbe, 0x51, 0x2e, 0x73, 0x85, 0x0f, 0x61, 0xfb, 0xc8, 0xc3, 0x3e, 0x76,
+	0xc6, 0xb8, 0x41, 0x3b, 0xbe, 0x29, 0x06, 0x4d, 0xb4, 0x87, 0xe5, 0x85, 0xf6, 0xf0, 0xef, 0x24,
+	0x50, 0x1f, 0x63, 0xd3, 0x0b, 0x8e, 0xb1, 0x19, 0xb5, 0xa6, 0xdf, 0x05, 0xc5, 0x75, 0x6c, 0xcb,
+	0xc1, 0x5c, 0xc4, 0x8a, 0x20, 0x62, 0x62, 0x37, 0x9d, 0xc3, 0xa1, 0x03, 0xc8, 0xbb, 0x27, 0x27,
+	0x14, 0x45, 0x5e, 0x83, 0x12, 0x02, 0x6e, 0xd0, 0xd8, 0x16, 0xde, 0x02, 0x72, 0x89, 0xb7, 0x80,
+	0xdf, 0x4a, 0x80, 0x0c, 0x1c, 0x84, 0x94, 0x57, 0x3b, 0xf3, 0x65, 0xb2, 0xa3, 0x77, 0x20, 0x47,
+	0x72, 0x94, 0x30, 0x97, 0xbe, 0xb3, 0xcc, 0x32, 0x49, 0x42, 0xb0, 0xce, 0xa0, 0x36, 0x28, 0x30,
+	0x05, 0x7e, 0x95, 0x04, 0xbf, 0x9f, 0x01, 0x6a, 0x6d, 0xc2, 0x2e, 0x82, 0xec, 0x9c, 0x5c, 0xca,
+	0x32, 0xad, 0xbe, 0xe8, 0xf7, 0x06, 0x31, 0xe8, 0x18, 0x2a, 0xc6, 0xfc, 0xd8, 0x1f, 0x7b, 0xd6,
+	0x31, 0x7e, 0x59, 0x7b, 0x9c, 0x42, 0x21, 0x24, 0x9d, 0x42, 0x33, 0xd2, 0xa4, 0xbc, 0x91, 0x26,
+	0xc3, 0x8b, 0xc0, 0xc7, 0xd8, 0xa9, 0x64, 0xe2, 0x8b, 0xc0, 0xc0, 0xd8, 0xd1, 0xfe, 0x46, 0xba,
+	0x3b, 0x91, 0x14, 0x57, 0x4e, 0x2e, 0xdf, 0x85, 0xe2, 0x19, 0xc7, 0x0e, 0x0b, 0x3f, 0xb4, 0xcc,
+	0x8e, 0x1e, 0x03, 0x6d, 0x54, 0xb0, 0xa7, 0xa6, 0xa3, 0x69, 0x5d, 0x0f, 0x09, 0x55, 0x61, 0x6f,
+	0xd0, 0xef, 0x8f, 0xba, 0xb5, 0xde, 0xd3, 0x91, 0x31, 0x7c, 0x64, 0xd4, 0xf5, 0xf6, 0xd1, 0xa0,
+	0xdd, 0xef, 0x19, 0xaa, 0x8c, 0x76, 0xa0, 0x44, 0x12, 0x58, 0xbd, 0xd9, 0xa9, 0x0d, 0x68, 0x7a,
+	0xfa, 0x57, 0x09, 0xca, 0x86, 0x35, 0x75, 0x4c, 0xfb, 0x2a, 0x0f, 0x2d, 0xe9, 0xe1, 0xf8, 0x5d,
+	0xfe, 0xe8, 0x97, 0xa5, 0x36, 0x78, 0x35, 0x16, 0x3a, 0xb1, 0xc1, 0x43, 0xda, 0xdd, 0x4b, 0x3e,
+	0x09, 0xe6, 0xe2, 0x27, 0xc1, 0xf5, 0x8d, 0x4a, 0xed, 0x00, 0xb2, 0x84, 0x06, 0x02, 0x50, 0x06,
+	0x4f, 0x8f, 0xda, 0xbd, 0x96, 0x7a, 0x83, 0xb4, 0x89, 0xd8, 0xf7, 0xc8, 0x18, 0xf4, 0x8f, 0x8e,
+	0x9a, 0x24, 0x3d, 0x07, 0x50, 0xea, 0x43, 0x63, 0xd0, 0xef, 0xaa, 0xb2, 0xf6, 0x7b, 0x09, 0xb6,
+	0x43, 0x36, 0x5e, 0x42, 0xbd, 0x70, 0x25, 0x03, 0x2d, 0xb6, 0xb8, 0xa2, 0xb6, 0x38, 0xed, 0x6c,
+	0xe9, 0xb5, 0x41, 0x73, 0xd4, 0x69, 0x77, 0xdb, 0x64, 0x26, 0xfb, 0xe0, 0x10, 0x4a, 0x42, 0x57,
+	0x14, 0x15, 0x20, 0x3b, 0x68, 0x7e, 0x3a, 0x50, 0x6f, 0x10, 0xac, 0x76, 0xb7, 0xd6, 0x6a, 0xaa,
+	0x12, 0x99, 0x3c, 0x6c, 0x77, 0x9a, 0xaa, 0x4c, 0xee, 0x91, 0x4e, 0xbf, 0x5e, 0x23, 0xe6, 0x56,
+	0x33, 0x82, 0x02, 0xb2, 0x0f, 0xde, 0x81, 0x62, 0x54, 0xa0, 0x93, 0x05, 0xbe, 0x3b, 0xa5, 0x53,
+	0x6b, 0x74, 0xdb, 0x3d, 0xd6, 0x9f, 0xef, 0x3f, 0xe9, 0x11, 0x9e, 0x1e, 0x1c, 0x40, 0x39, 0x71,
+	0x72, 0x88, 0x5c, 0xfd, 0xc3, 0xc3, 0x4e, 0xbb, 0xd7, 0x54, 0x6f, 0x10, 0xfc, 0x7e, 0x8f, 0x7e,
+	0xd3, 0xcd, 0x6b, 0x4f, 0x6a, 0x4f, 0x55, 0xf9, 0xe0, 0x1f, 0xbb, 0xb0, 0xd5, 0x21, 0x7f, 0x90,
+	0x30, 0xb0, 0x47, 0xaf, 0x82, 0x6f, 0x42, 0x96, 0x3c, 0x98, 0x22, 0xa1, 0xf1, 0x21, 0x3c, 0xf6,
+	0x56, 0xf7, 0x16, 0xa7, 0xb9, 0x61, 0xde, 0x07, 0x85, 0xbd, 0x18, 0xa2, 0x3b, 0xe2, 0x5f, 0x10,
+	0x84, 0xb7, 0xcb, 0x6a, 0x65, 0x79, 0x81, 0x23, 0x7f, 0x07, 0xf2, 0xfc, 0xed, 0x0c, 0x09, 0x40,
+	0xc9, 0xc7, 0xc4, 0xea, 0x7e, 0xca, 0x8a, 0x88, 0xdf, 0x5a, 0xc6, 0x6f, 0xad, 0xc4, 0x4f, 0x3e,
+	0xc4, 0x74, 0x20, 0xcf, 0x9b, 0xcf, 0xe8, 0x75, 0x11, 0x2a, 0xe5, 0xe1, 0xa1, 0x7a, 0x6f, 0x35,
+	0x40, 0xa4, 0x0a, 0xe0, 0x8f, 0x69, 0xb5, 0xf1, 0xe7, 0x28, 0xc9, 0xb6, 0xf8, 0x4e, 0x52, 0x45,
+	0x62, 0x59, 0x22, 0x22, 0xb7, 0x52, 0x91, 0x5b, 0x6b, 0x91, 0xdb, 0x50, 0x8c, 0x5a, 0xdc, 0xa8,
+	0x9a, 0x4c, 0x31, 0xc4, 0xbe, 0xf7, 0x06, 0x42, 0x34, 0xa0, 0x24, 0xb4, 0x5b, 0x91, 0x10, 0x18,
+	0x96, 0xbb, 0xb0, 0xd5, 0x3b, 0x8b, 0x0d, 0x25, 0x81, 0x8a, 0xd0, 0x28, 0x15, 0xa9, 0x2c, 0xf7,
+	0x4f, 0x57, 0x53, 0xf9, 0x10, 0xb6, 0x1a, 0x96, 0x7f, 0x6c, 0x3a, 0x13, 0x46, 0x66, 0x6f, 0x09,
+	0x70, 0x0d, 0x81, 0x0f, 0xa0, 0xf8, 0xb1, 0x6b, 0x39, 0xd7, 0xc4, 0xfe, 0x36, 0x40, 0x07, 0x9b,
+	0xe7, 0xf8, 0x9a, 0xe8, 0x1d, 0x40, 0x6d, 0xe7, 0xdc, 0x0a, 0xb0, 0xd0, 0x3f, 0xf4, 0xd1, 0xdd,
+	0xd4, 0xbe, 0xa2, 0xbf, 0x09, 0x35, 0x1d, 0xcf, 0xdc, 0xf3, 0x17, 0x43, 0xad, 0x07, 0xea, 0x62,
+	0xd3, 0x74, 0xa5, 0x80, 0x42, 0x8a, 0xbd, 0xb2, 0xd1, 0x6a, 0xc0, 0x76, 0xb2, 0x51, 0x28, 0x9e,
+	0xa7, 0xd4, 0x2e, 0x68, 0xf5, 0xde, 0x6a, 0x00, 0x4e, 0xf4, 0x23, 0x28, 0x46, 0x4d, 0x41, 0xd1,
+	0xab, 0x17, 0x3b, 0x85, 0xab, 0xc5, 0x7c, 0x0c, 0xe5, 0x44, 0x87, 0x0f, 0xbd, 0x26, 0xdc, 0x73,
+	0x29, 0xad, 0xbf, 0xd5, 0x94, 0x74, 0x40, 0xcb, 0xbd, 0x39, 0x74, 0x3f, 0x06, 0x5f, 0xd9, 0xb9,
+	0x5b, 0x4d, 0xf3, 0x7d, 0x50, 0x58, 0x21, 0x2c, 0x86, 0xce, 0x44, 0x13, 0xae, 0x5a, 0x59, 0x5e,
+	0xe0, 0xc8, 0x35, 0x28, 0x84, 0xdd, 0x21, 0x31, 0x5a, 0x2c, 0x74, 0xaf, 0xaa, 0xd5, 0xb4, 0x25,
+	0x4e, 0xe2, 0x63, 0x28, 0xb7, 0x70, 0x10, 0xb7, 0x51, 0xd0, 0x2b, 0x29, 0xed, 0x92, 0xc8, 0x5e,
+	0xaf, 0xa6, 0x2f, 0x72, 0x5a, 0x9f, 0xc1, 0xee, 0x52, 0xdd, 0x8d, 0x16, 0x3c, 0x27, 0xad, 0x8f,
+	0x52, 0xbd, 0x7f, 0x29, 0x4c, 0xc4, 0x69, 0x49, 0xa8, 0x61, 0x13, 0x41, 0x69, 0xa9, 0x9c, 0xae,
+	0xde, 0x5d, 0xb1, 0x1a, 0xdf, 0x19, 0xbc, 0x42, 0x13, 0xef, 0x8c, 0x64, 0xf1, 0x58, 0xdd, 0x4f,
+	0x59, 0x89, 0xac, 0x56, 0x8c, 0x4a, 0x20, 0xd1, 0x2b, 0x17, 0xeb, 0xa2, 0xd4, 0x40, 0xdd, 0x82,
+	0x92, 0x50, 0x89, 0x88, 0x82, 0x2c, 0x17, 0x28, 0xa2, 0xed, 0x96, 0x52, 0xdc, 0x16, 0x94, 0x5a,
+	0xe9, 0x84, 0x5a, 0x57, 0x23, 0x64, 0xc0, 0xee, 0x52, 0x39, 0x20, 0x1a, 0x6e, 0x55, 0xad, 0x70,
+	0x29, 0xd1, 0x21, 0xdc, 0x1c, 0x3a, 0xfe, 0x0b, 0x27, 0xfb, 0x3e, 0x28, 0x2c, 0x2d, 0x14, 0x0f,
+	0x4c, 0x22, 0x5f, 0xad, 0x56, 0x96, 0x17, 0x18, 0xf2, 0xb1, 0x42, 0x17, 0xbe, 0xf1, 0xaf, 0x01,
+	0x00, 0x9e, 0x09, 0xad, 0x9e, 0x17, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ *grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LogicServiceClient is the client API for LogicService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LogicServiceClient interface {
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	C2CSend(ctx context.Context, in *C2CSendRequest, opts ...grpc.CallOption) (*C2CSendResponse, error)
+	C2GSend(ctx context.Context, in *C2GSendRequest, opts ...grpc.CallOption) (*C2GSendResponse, error)
+	C2SPull(ctx context.Context, in *C2SPullMessageRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error)
+	C2CPushAck(ctx context.Context, in *C2CPushResponse, opts ...grpc.CallOption) (*Response, error)
+	C2GPushAck(ctx context.Context, in *C2GPushResponse, opts ...grpc.CallOption) (*Response, error)
+	PullBySeq(ctx context.Context, in *PullBySeqRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	DisbandGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	JoinGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	LeaveGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	InviteGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	ListGroupMembers(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
+	ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error)
+	MuteGroup(ctx context.Context, in *MuteGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	Recall(ctx context.Context, in *RecallRequest, opts ...grpc.CallOption) (*RecallResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	GetReadCounts(ctx context.Context, in *ReadCountsRequest, opts ...grpc.CallOption) (*ReadCountsResponse, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	ClearUnread(ctx context.Context, in *ClearUnreadRequest, opts ...grpc.CallOption) (*ClearUnreadResponse, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*Response, error)
+	SetPresence(ctx context.Context, in *SetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	UnsubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
+}
+
+type logicServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLogicServiceClient(cc *grpc.ClientConn) LogicServiceClient {
+	return &logicServiceClient{cc}
 }
 
 func (c *logicServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
@@ -976,226 +4750,1001 @@ func (c *logicServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *logicServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) C2CSend(ctx context.Context, in *C2CSendRequest, opts ...grpc.CallOption) (*C2CSendResponse, error) {
+	out := new(C2CSendResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2CSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) C2GSend(ctx context.Context, in *C2GSendRequest, opts ...grpc.CallOption) (*C2GSendResponse, error) {
+	out := new(C2GSendResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2GSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) C2SPull(ctx context.Context, in *C2SPullMessageRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error) {
+	out := new(C2SPullMessageResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2SPull", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) C2CPushAck(ctx context.Context, in *C2CPushResponse, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2CPushAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) C2GPushAck(ctx context.Context, in *C2GPushResponse, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2GPushAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) PullBySeq(ctx context.Context, in *PullBySeqRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error) {
+	out := new(C2SPullMessageResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/PullBySeq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/RenameGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) DisbandGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/DisbandGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) JoinGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/JoinGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) LeaveGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) InviteGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/InviteGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) RemoveGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/RemoveGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) ListGroupMembers(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error) {
+	out := new(ListGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/ListGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error) {
+	out := new(ListUserGroupsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/ListUserGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) MuteGroup(ctx context.Context, in *MuteGroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/MuteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) SetGroupAdmin(ctx context.Context, in *SetGroupAdminRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/SetGroupAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/TransferGroupOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) Recall(ctx context.Context, in *RecallRequest, opts ...grpc.CallOption) (*RecallResponse, error) {
+	out := new(RecallResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/Recall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) GetReadCounts(ctx context.Context, in *ReadCountsRequest, opts ...grpc.CallOption) (*ReadCountsResponse, error) {
+	out := new(ReadCountsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/GetReadCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/ListConversations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) ClearUnread(ctx context.Context, in *ClearUnreadRequest, opts ...grpc.CallOption) (*ClearUnreadResponse, error) {
+	out := new(ClearUnreadResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/ClearUnread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) SetPresence(ctx context.Context, in *SetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/SetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/GetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/SubscribePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) UnsubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/UnsubscribePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicServiceClient) Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error) {
+	out := new(SignalResponse)
+	err := c.cc.Invoke(ctx, "/protocol.LogicService/Signal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogicServiceServer is the server API for LogicService service.
+type LogicServiceServer interface {
+	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	C2CSend(context.Context, *C2CSendRequest) (*C2CSendResponse, error)
+	C2GSend(context.Context, *C2GSendRequest) (*C2GSendResponse, error)
+	C2SPull(context.Context, *C2SPullMessageRequest) (*C2SPullMessageResponse, error)
+	C2CPushAck(context.Context, *C2CPushResponse) (*Response, error)
+	C2GPushAck(context.Context, *C2GPushResponse) (*Response, error)
+	PullBySeq(context.Context, *PullBySeqRequest) (*C2SPullMessageResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*GroupResponse, error)
+	RenameGroup(context.Context, *RenameGroupRequest) (*GroupResponse, error)
+	DisbandGroup(context.Context, *GroupRequest) (*GroupResponse, error)
+	JoinGroup(context.Context, *GroupRequest) (*GroupResponse, error)
+	LeaveGroup(context.Context, *GroupRequest) (*GroupResponse, error)
+	InviteGroupMembers(context.Context, *GroupMembersRequest) (*GroupResponse, error)
+	RemoveGroupMembers(context.Context, *GroupMembersRequest) (*GroupResponse, error)
+	ListGroupMembers(context.Context, *GroupRequest) (*ListGroupMembersResponse, error)
+	ListUserGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error)
+	MuteGroup(context.Context, *MuteGroupRequest) (*GroupResponse, error)
+	SetGroupAdmin(context.Context, *SetGroupAdminRequest) (*GroupResponse, error)
+	TransferGroupOwner(context.Context, *TransferGroupOwnerRequest) (*GroupResponse, error)
+	Recall(context.Context, *RecallRequest) (*RecallResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
+	GetReadCounts(context.Context, *ReadCountsRequest) (*ReadCountsResponse, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	ClearUnread(context.Context, *ClearUnreadRequest) (*ClearUnreadResponse, error)
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*Response, error)
+	SetPresence(context.Context, *SetPresenceRequest) (*PresenceResponse, error)
+	GetPresence(context.Context, *GetPresenceRequest) (*PresenceResponse, error)
+	SubscribePresence(context.Context, *SubscribePresenceRequest) (*PresenceResponse, error)
+	UnsubscribePresence(context.Context, *SubscribePresenceRequest) (*PresenceResponse, error)
+	Signal(context.Context, *SignalRequest) (*SignalResponse, error)
+}
+
+// UnimplementedLogicServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLogicServiceServer struct {
+}
+
+func (*UnimplementedLogicServiceServer) Auth(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+func (*UnimplementedLogicServiceServer) Logout(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedLogicServiceServer) C2CSend(ctx context.Context, req *C2CSendRequest) (*C2CSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method C2CSend not implemented")
+}
+func (*UnimplementedLogicServiceServer) C2GSend(ctx context.Context, req *C2GSendRequest) (*C2GSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method C2GSend not implemented")
+}
+func (*UnimplementedLogicServiceServer) C2SPull(ctx context.Context, req *C2SPullMessageRequest) (*C2SPullMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method C2SPull not implemented")
+}
+func (*UnimplementedLogicServiceServer) C2CPushAck(ctx context.Context, req *C2CPushResponse) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method C2CPushAck not implemented")
+}
+func (*UnimplementedLogicServiceServer) C2GPushAck(ctx context.Context, req *C2GPushResponse) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method C2GPushAck not implemented")
+}
+func (*UnimplementedLogicServiceServer) PullBySeq(ctx context.Context, req *PullBySeqRequest) (*C2SPullMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullBySeq not implemented")
+}
+func (*UnimplementedLogicServiceServer) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) RenameGroup(ctx context.Context, req *RenameGroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) DisbandGroup(ctx context.Context, req *GroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisbandGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) JoinGroup(ctx context.Context, req *GroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) LeaveGroup(ctx context.Context, req *GroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) InviteGroupMembers(ctx context.Context, req *GroupMembersRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteGroupMembers not implemented")
+}
+func (*UnimplementedLogicServiceServer) RemoveGroupMembers(ctx context.Context, req *GroupMembersRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (*UnimplementedLogicServiceServer) ListGroupMembers(ctx context.Context, req *GroupRequest) (*ListGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (*UnimplementedLogicServiceServer) ListUserGroups(ctx context.Context, req *ListUserGroupsRequest) (*ListUserGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserGroups not implemented")
+}
+func (*UnimplementedLogicServiceServer) MuteGroup(ctx context.Context, req *MuteGroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteGroup not implemented")
+}
+func (*UnimplementedLogicServiceServer) SetGroupAdmin(ctx context.Context, req *SetGroupAdminRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAdmin not implemented")
+}
+func (*UnimplementedLogicServiceServer) TransferGroupOwner(ctx context.Context, req *TransferGroupOwnerRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGroupOwner not implemented")
+}
+func (*UnimplementedLogicServiceServer) Recall(ctx context.Context, req *RecallRequest) (*RecallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recall not implemented")
+}
+func (*UnimplementedLogicServiceServer) MarkRead(ctx context.Context, req *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (*UnimplementedLogicServiceServer) GetReadCounts(ctx context.Context, req *ReadCountsRequest) (*ReadCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadCounts not implemented")
+}
+func (*UnimplementedLogicServiceServer) ListConversations(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (*UnimplementedLogicServiceServer) ClearUnread(ctx context.Context, req *ClearUnreadRequest) (*ClearUnreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearUnread not implemented")
+}
+func (*UnimplementedLogicServiceServer) History(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedLogicServiceServer) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedLogicServiceServer) SetPresence(ctx context.Context, req *SetPresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPresence not implemented")
+}
+func (*UnimplementedLogicServiceServer) GetPresence(ctx context.Context, req *GetPresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (*UnimplementedLogicServiceServer) SubscribePresence(ctx context.Context, req *SubscribePresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (*UnimplementedLogicServiceServer) UnsubscribePresence(ctx context.Context, req *SubscribePresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribePresence not implemented")
+}
+func (*UnimplementedLogicServiceServer) Signal(ctx context.Context, req *SignalRequest) (*SignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+
+func RegisterLogicServiceServer(s *grpc.Server, srv LogicServiceServer) {
+	s.RegisterService(&_LogicService_serviceDesc, srv)
+}
+
+func _LogicService_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/Auth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).Auth(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_C2CSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2CSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).C2CSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/C2CSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).C2CSend(ctx, req.(*C2CSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_C2GSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2GSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).C2GSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/C2GSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).C2GSend(ctx, req.(*C2GSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_C2SPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2SPullMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).C2SPull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/C2SPull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).C2SPull(ctx, req.(*C2SPullMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_C2CPushAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2CPushResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).C2CPushAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/C2CPushAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).C2CPushAck(ctx, req.(*C2CPushResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_C2GPushAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2GPushResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).C2GPushAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/C2GPushAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).C2GPushAck(ctx, req.(*C2GPushResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_PullBySeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullBySeqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).PullBySeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/PullBySeq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).PullBySeq(ctx, req.(*PullBySeqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).RenameGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/RenameGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_DisbandGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).DisbandGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/DisbandGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).DisbandGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).JoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/JoinGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).JoinGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).LeaveGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_InviteGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).InviteGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/InviteGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).InviteGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/RemoveGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).RemoveGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
-	out := new(LogoutResponse)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/Logout", in, out, opts...)
-	if err != nil {
+func _LogicService_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/ListGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).ListGroupMembers(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) C2CSend(ctx context.Context, in *C2CSendRequest, opts ...grpc.CallOption) (*C2CSendResponse, error) {
-	out := new(C2CSendResponse)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2CSend", in, out, opts...)
-	if err != nil {
+func _LogicService_ListUserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGroupsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).ListUserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/ListUserGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).ListUserGroups(ctx, req.(*ListUserGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) C2GSend(ctx context.Context, in *C2GSendRequest, opts ...grpc.CallOption) (*C2GSendResponse, error) {
-	out := new(C2GSendResponse)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2GSend", in, out, opts...)
-	if err != nil {
+func _LogicService_MuteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).MuteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/MuteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).MuteGroup(ctx, req.(*MuteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) C2SPull(ctx context.Context, in *C2SPullMessageRequest, opts ...grpc.CallOption) (*C2SPullMessageResponse, error) {
-	out := new(C2SPullMessageResponse)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2SPull", in, out, opts...)
-	if err != nil {
+func _LogicService_SetGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAdminRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).SetGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/SetGroupAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).SetGroupAdmin(ctx, req.(*SetGroupAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) C2CPushAck(ctx context.Context, in *C2CPushResponse, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2CPushAck", in, out, opts...)
-	if err != nil {
+func _LogicService_TransferGroupOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGroupOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).TransferGroupOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/TransferGroupOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).TransferGroupOwner(ctx, req.(*TransferGroupOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *logicServiceClient) C2GPushAck(ctx context.Context, in *C2GPushResponse, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/protocol.LogicService/C2GPushAck", in, out, opts...)
-	if err != nil {
+func _LogicService_Recall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LogicServiceServer).Recall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/Recall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).Recall(ctx, req.(*RecallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// LogicServiceServer is the server API for LogicService service.
-type LogicServiceServer interface {
-	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
-	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
-	C2CSend(context.Context, *C2CSendRequest) (*C2CSendResponse, error)
-	C2GSend(context.Context, *C2GSendRequest) (*C2GSendResponse, error)
-	C2SPull(context.Context, *C2SPullMessageRequest) (*C2SPullMessageResponse, error)
-	C2CPushAck(context.Context, *C2CPushResponse) (*Response, error)
-	C2GPushAck(context.Context, *C2GPushResponse) (*Response, error)
+func _LogicService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedLogicServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedLogicServiceServer struct {
+func _LogicService_GetReadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).GetReadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/GetReadCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).GetReadCounts(ctx, req.(*ReadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedLogicServiceServer) Auth(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
-}
-func (*UnimplementedLogicServiceServer) Logout(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
-}
-func (*UnimplementedLogicServiceServer) C2CSend(ctx context.Context, req *C2CSendRequest) (*C2CSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method C2CSend not implemented")
-}
-func (*UnimplementedLogicServiceServer) C2GSend(ctx context.Context, req *C2GSendRequest) (*C2GSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method C2GSend not implemented")
-}
-func (*UnimplementedLogicServiceServer) C2SPull(ctx context.Context, req *C2SPullMessageRequest) (*C2SPullMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method C2SPull not implemented")
-}
-func (*UnimplementedLogicServiceServer) C2CPushAck(ctx context.Context, req *C2CPushResponse) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method C2CPushAck not implemented")
-}
-func (*UnimplementedLogicServiceServer) C2GPushAck(ctx context.Context, req *C2GPushResponse) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method C2GPushAck not implemented")
+func _LogicService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/ListConversations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLogicServiceServer(s *grpc.Server, srv LogicServiceServer) {
-	s.RegisterService(&_LogicService_serviceDesc, srv)
+func _LogicService_ClearUnread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUnreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicServiceServer).ClearUnread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.LogicService/ClearUnread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicServiceServer).ClearUnread(ctx, req.(*ClearUnreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _LogicService_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).Auth(ctx, in)
+		return srv.(LogicServiceServer).History(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/Auth",
+		FullMethod: "/protocol.LogicService/History",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).Auth(ctx, req.(*AuthRequest))
+		return srv.(LogicServiceServer).History(ctx, req.(*HistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _LogicService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).Logout(ctx, in)
+		return srv.(LogicServiceServer).Heartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/Logout",
+		FullMethod: "/protocol.LogicService/Heartbeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).Logout(ctx, req.(*LogoutRequest))
+		return srv.(LogicServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_C2CSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2CSendRequest)
+func _LogicService_SetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).C2CSend(ctx, in)
+		return srv.(LogicServiceServer).SetPresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/C2CSend",
+		FullMethod: "/protocol.LogicService/SetPresence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).C2CSend(ctx, req.(*C2CSendRequest))
+		return srv.(LogicServiceServer).SetPresence(ctx, req.(*SetPresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_C2GSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2GSendRequest)
+func _LogicService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).C2GSend(ctx, in)
+		return srv.(LogicServiceServer).GetPresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/C2GSend",
+		FullMethod: "/protocol.LogicService/GetPresence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).C2GSend(ctx, req.(*C2GSendRequest))
+		return srv.(LogicServiceServer).GetPresence(ctx, req.(*GetPresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_C2SPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2SPullMessageRequest)
+func _LogicService_SubscribePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribePresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).C2SPull(ctx, in)
+		return srv.(LogicServiceServer).SubscribePresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/C2SPull",
+		FullMethod: "/protocol.LogicService/SubscribePresence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).C2SPull(ctx, req.(*C2SPullMessageRequest))
+		return srv.(LogicServiceServer).SubscribePresence(ctx, req.(*SubscribePresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_C2CPushAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2CPushResponse)
+func _LogicService_UnsubscribePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribePresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).C2CPushAck(ctx, in)
+		return srv.(LogicServiceServer).UnsubscribePresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/C2CPushAck",
+		FullMethod: "/protocol.LogicService/UnsubscribePresence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).C2CPushAck(ctx, req.(*C2CPushResponse))
+		return srv.(LogicServiceServer).UnsubscribePresence(ctx, req.(*SubscribePresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LogicService_C2GPushAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2GPushResponse)
+func _LogicService_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LogicServiceServer).C2GPushAck(ctx, in)
+		return srv.(LogicServiceServer).Signal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protocol.LogicService/C2GPushAck",
+		FullMethod: "/protocol.LogicService/Signal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LogicServiceServer).C2GPushAck(ctx, req.(*C2GPushResponse))
+		return srv.(LogicServiceServer).Signal(ctx, req.(*SignalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1232,6 +5781,106 @@ var _LogicService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "C2GPushAck",
 			Handler:    _LogicService_C2GPushAck_Handler,
 		},
+		{
+			MethodName: "PullBySeq",
+			Handler:    _LogicService_PullBySeq_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _LogicService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "RenameGroup",
+			Handler:    _LogicService_RenameGroup_Handler,
+		},
+		{
+			MethodName: "DisbandGroup",
+			Handler:    _LogicService_DisbandGroup_Handler,
+		},
+		{
+			MethodName: "JoinGroup",
+			Handler:    _LogicService_JoinGroup_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _LogicService_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "InviteGroupMembers",
+			Handler:    _LogicService_InviteGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _LogicService_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _LogicService_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListUserGroups",
+			Handler:    _LogicService_ListUserGroups_Handler,
+		},
+		{
+			MethodName: "MuteGroup",
+			Handler:    _LogicService_MuteGroup_Handler,
+		},
+		{
+			MethodName: "SetGroupAdmin",
+			Handler:    _LogicService_SetGroupAdmin_Handler,
+		},
+		{
+			MethodName: "TransferGroupOwner",
+			Handler:    _LogicService_TransferGroupOwner_Handler,
+		},
+		{
+			MethodName: "Recall",
+			Handler:    _LogicService_Recall_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _LogicService_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetReadCounts",
+			Handler:    _LogicService_GetReadCounts_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _LogicService_ListConversations_Handler,
+		},
+		{
+			MethodName: "ClearUnread",
+			Handler:    _LogicService_ClearUnread_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _LogicService_History_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _LogicService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "SetPresence",
+			Handler:    _LogicService_SetPresence_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _LogicService_GetPresence_Handler,
+		},
+		{
+			MethodName: "SubscribePresence",
+			Handler:    _LogicService_SubscribePresence_Handler,
+		},
+		{
+			MethodName: "UnsubscribePresence",
+			Handler:    _LogicService_UnsubscribePresence_Handler,
+		},
+		{
+			MethodName: "Signal",
+			Handler:    _LogicService_Signal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logic.proto",
@@ -24,18 +24,112 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// 消息内容的类型，和logic.proto中的定义保持一致
+type ContentType int32
+
+const (
+	ContentType_TEXT     ContentType = 0
+	ContentType_IMAGE    ContentType = 1
+	ContentType_FILE     ContentType = 2
+	ContentType_LOCATION ContentType = 3
+	ContentType_CUSTOM   ContentType = 4
+)
+
+var ContentType_name = map[int32]string{
+	0: "TEXT",
+	1: "IMAGE",
+	2: "FILE",
+	3: "LOCATION",
+	4: "CUSTOM",
+}
+
+var ContentType_value = map[string]int32{
+	"TEXT":     0,
+	"IMAGE":    1,
+	"FILE":     2,
+	"LOCATION": 3,
+	"CUSTOM":   4,
+}
+
+func (x ContentType) String() string {
+	return proto.EnumName(ContentType_name, int32(x))
+}
+
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{0}
+}
+
+type PresenceState int32
+
+const (
+	PresenceState_OFFLINE PresenceState = 0
+	PresenceState_ONLINE  PresenceState = 1
+	PresenceState_AWAY    PresenceState = 2
+)
+
+var PresenceState_name = map[int32]string{
+	0: "OFFLINE",
+	1: "ONLINE",
+	2: "AWAY",
+}
+
+var PresenceState_value = map[string]int32{
+	"OFFLINE": 0,
+	"ONLINE":  1,
+	"AWAY":    2,
+}
+
+func (x PresenceState) String() string {
+	return proto.EnumName(PresenceState_name, int32(x))
+}
+
+func (PresenceState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{1}
+}
+
+type SignalType int32
+
+const (
+	SignalType_TYPING         SignalType = 0
+	SignalType_TYPING_STOPPED SignalType = 1
+	SignalType_CUSTOM         SignalType = 2
+)
+
+var SignalType_name = map[int32]string{
+	0: "TYPING",
+	1: "TYPING_STOPPED",
+	2: "CUSTOM",
+}
+
+var SignalType_value = map[string]int32{
+	"TYPING":         0,
+	"TYPING_STOPPED": 1,
+	"CUSTOM":         2,
+}
+
+func (x SignalType) String() string {
+	return proto.EnumName(SignalType_name, int32(x))
+}
+
+func (SignalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{2}
+}
+
 type KickOutRequest_Reason int32
 
 const (
 	KickOutRequest_OTHER_LOGIN KickOutRequest_Reason = 0
+	KickOutRequest_LOGOUT      KickOutRequest_Reason = 1
 )
 
 var KickOutRequest_Reason_name = map[int32]string{
 	0: "OTHER_LOGIN",
+	1: "LOGOUT",
 }
 
 var KickOutRequest_Reason_value = map[string]int32{
 	"OTHER_LOGIN": 0,
+	"LOGOUT":      1,
 }
 
 func (x KickOutRequest_Reason) String() string {
@@ -43,7 +137,7 @@ func (x KickOutRequest_Reason) String() string {
 }
 
 func (KickOutRequest_Reason) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d1e4bfd2e9d102bb, []int{1, 0}
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{7, 0}
 }
 
 type Response struct {
@@ -93,11 +187,437 @@ func (m *Response) GetSeq() int64 {
 	return 0
 }
 
+// 结构化的消息内容
+type Payload struct {
+	// Types that are valid to be assigned to Body:
+	//	*Payload_Text
+	//	*Payload_Image
+	//	*Payload_File
+	//	*Payload_Location
+	//	*Payload_Custom
+	Body                 isPayload_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Payload) Reset()         { *m = Payload{} }
+func (m *Payload) String() string { return proto.CompactTextString(m) }
+func (*Payload) ProtoMessage()    {}
+func (*Payload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{1}
+}
+
+func (m *Payload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payload.Unmarshal(m, b)
+}
+func (m *Payload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payload.Marshal(b, m, deterministic)
+}
+func (m *Payload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payload.Merge(m, src)
+}
+func (m *Payload) XXX_Size() int {
+	return xxx_messageInfo_Payload.Size(m)
+}
+func (m *Payload) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payload proto.InternalMessageInfo
+
+type isPayload_Body interface {
+	isPayload_Body()
+}
+
+type Payload_Text struct {
+	Text *TextPayload `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Payload_Image struct {
+	Image *ImagePayload `protobuf:"bytes,2,opt,name=image,proto3,oneof"`
+}
+
+type Payload_File struct {
+	File *FilePayload `protobuf:"bytes,3,opt,name=file,proto3,oneof"`
+}
+
+type Payload_Location struct {
+	Location *LocationPayload `protobuf:"bytes,4,opt,name=location,proto3,oneof"`
+}
+
+type Payload_Custom struct {
+	Custom *CustomPayload `protobuf:"bytes,5,opt,name=custom,proto3,oneof"`
+}
+
+func (*Payload_Text) isPayload_Body() {}
+
+func (*Payload_Image) isPayload_Body() {}
+
+func (*Payload_File) isPayload_Body() {}
+
+func (*Payload_Location) isPayload_Body() {}
+
+func (*Payload_Custom) isPayload_Body() {}
+
+func (m *Payload) GetBody() isPayload_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Payload) GetText() *TextPayload {
+	if x, ok := m.GetBody().(*Payload_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (m *Payload) GetImage() *ImagePayload {
+	if x, ok := m.GetBody().(*Payload_Image); ok {
+		return x.Image
+	}
+	return nil
+}
+
+func (m *Payload) GetFile() *FilePayload {
+	if x, ok := m.GetBody().(*Payload_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (m *Payload) GetLocation() *LocationPayload {
+	if x, ok := m.GetBody().(*Payload_Location); ok {
+		return x.Location
+	}
+	return nil
+}
+
+func (m *Payload) GetCustom() *CustomPayload {
+	if x, ok := m.GetBody().(*Payload_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Payload) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Payload_Text)(nil),
+		(*Payload_Image)(nil),
+		(*Payload_File)(nil),
+		(*Payload_Location)(nil),
+		(*Payload_Custom)(nil),
+	}
+}
+
+type TextPayload struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TextPayload) Reset()         { *m = TextPayload{} }
+func (m *TextPayload) String() string { return proto.CompactTextString(m) }
+func (*TextPayload) ProtoMessage()    {}
+func (*TextPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{2}
+}
+
+func (m *TextPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextPayload.Unmarshal(m, b)
+}
+func (m *TextPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextPayload.Marshal(b, m, deterministic)
+}
+func (m *TextPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextPayload.Merge(m, src)
+}
+func (m *TextPayload) XXX_Size() int {
+	return xxx_messageInfo_TextPayload.Size(m)
+}
+func (m *TextPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextPayload proto.InternalMessageInfo
+
+func (m *TextPayload) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type ImagePayload struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl         string   `protobuf:"bytes,2,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Width                int32    `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height               int32    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Size                 int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	BlobId               string   `protobuf:"bytes,7,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePayload) Reset()         { *m = ImagePayload{} }
+func (m *ImagePayload) String() string { return proto.CompactTextString(m) }
+func (*ImagePayload) ProtoMessage()    {}
+func (*ImagePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{3}
+}
+
+func (m *ImagePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImagePayload.Unmarshal(m, b)
+}
+func (m *ImagePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImagePayload.Marshal(b, m, deterministic)
+}
+func (m *ImagePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePayload.Merge(m, src)
+}
+func (m *ImagePayload) XXX_Size() int {
+	return xxx_messageInfo_ImagePayload.Size(m)
+}
+func (m *ImagePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePayload proto.InternalMessageInfo
+
+func (m *ImagePayload) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ImagePayload) GetThumbnailUrl() string {
+	if m != nil {
+		return m.ThumbnailUrl
+	}
+	return ""
+}
+
+func (m *ImagePayload) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ImagePayload) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ImagePayload) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ImagePayload) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *ImagePayload) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+type FilePayload struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType             string   `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	BlobId               string   `protobuf:"bytes,5,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilePayload) Reset()         { *m = FilePayload{} }
+func (m *FilePayload) String() string { return proto.CompactTextString(m) }
+func (*FilePayload) ProtoMessage()    {}
+func (*FilePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{4}
+}
+
+func (m *FilePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilePayload.Unmarshal(m, b)
+}
+func (m *FilePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilePayload.Marshal(b, m, deterministic)
+}
+func (m *FilePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilePayload.Merge(m, src)
+}
+func (m *FilePayload) XXX_Size() int {
+	return xxx_messageInfo_FilePayload.Size(m)
+}
+func (m *FilePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilePayload proto.InternalMessageInfo
+
+func (m *FilePayload) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *FilePayload) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FilePayload) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FilePayload) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *FilePayload) GetBlobId() string {
+	if m != nil {
+		return m.BlobId
+	}
+	return ""
+}
+
+type LocationPayload struct {
+	Latitude             float64  `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            float64  `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocationPayload) Reset()         { *m = LocationPayload{} }
+func (m *LocationPayload) String() string { return proto.CompactTextString(m) }
+func (*LocationPayload) ProtoMessage()    {}
+func (*LocationPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{5}
+}
+
+func (m *LocationPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationPayload.Unmarshal(m, b)
+}
+func (m *LocationPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationPayload.Marshal(b, m, deterministic)
+}
+func (m *LocationPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationPayload.Merge(m, src)
+}
+func (m *LocationPayload) XXX_Size() int {
+	return xxx_messageInfo_LocationPayload.Size(m)
+}
+func (m *LocationPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationPayload proto.InternalMessageInfo
+
+func (m *LocationPayload) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *LocationPayload) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *LocationPayload) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type CustomPayload struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CustomPayload) Reset()         { *m = CustomPayload{} }
+func (m *CustomPayload) String() string { return proto.CompactTextString(m) }
+func (*CustomPayload) ProtoMessage()    {}
+func (*CustomPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{6}
+}
+
+func (m *CustomPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomPayload.Unmarshal(m, b)
+}
+func (m *CustomPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomPayload.Marshal(b, m, deterministic)
+}
+func (m *CustomPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomPayload.Merge(m, src)
+}
+func (m *CustomPayload) XXX_Size() int {
+	return xxx_messageInfo_CustomPayload.Size(m)
+}
+func (m *CustomPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomPayload proto.InternalMessageInfo
+
+func (m *CustomPayload) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CustomPayload) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 type KickOutRequest struct {
 	Reason               int32    `protobuf:"varint,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	Ts                   int64    `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
 	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
 	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	DeviceId             string   `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -107,7 +627,7 @@ func (m *KickOutRequest) Reset()         { *m = KickOutRequest{} }
 func (m *KickOutRequest) String() string { return proto.CompactTextString(m) }
 func (*KickOutRequest) ProtoMessage()    {}
 func (*KickOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1e4bfd2e9d102bb, []int{1}
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{7}
 }
 
 func (m *KickOutRequest) XXX_Unmarshal(b []byte) error {
@@ -156,10 +676,17 @@ func (m *KickOutRequest) GetUid() string {
 	return ""
 }
 
-type KickOutResponse struct {
-	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+func (m *KickOutRequest) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+type KickOutResponse struct {
+	Ts                   int64    `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -168,7 +695,7 @@ func (m *KickOutResponse) Reset()         { *m = KickOutResponse{} }
 func (m *KickOutResponse) String() string { return proto.CompactTextString(m) }
 func (*KickOutResponse) ProtoMessage()    {}
 func (*KickOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1e4bfd2e9d102bb, []int{2}
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{8}
 }
 
 func (m *KickOutResponse) XXX_Unmarshal(b []byte) error {
@@ -187,184 +714,892 @@ func (m *KickOutResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_KickOutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KickOutResponse proto.InternalMessageInfo
-
-func (m *KickOutResponse) GetTs() int64 {
+var xxx_messageInfo_KickOutResponse proto.InternalMessageInfo
+
+func (m *KickOutResponse) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *KickOutResponse) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 推送给接收者的协议
+type C2CPushRequest struct {
+	From                 string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Content              string      `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MsgId                int64       `protobuf:"varint,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64       `protobuf:"varint,5,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConvSeq              int64       `protobuf:"varint,7,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	ContentType          ContentType `protobuf:"varint,8,opt,name=content_type,json=contentType,proto3,enum=push.ContentType" json:"content_type,omitempty"`
+	Payload              *Payload    `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *C2CPushRequest) Reset()         { *m = C2CPushRequest{} }
+func (m *C2CPushRequest) String() string { return proto.CompactTextString(m) }
+func (*C2CPushRequest) ProtoMessage()    {}
+func (*C2CPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{9}
+}
+
+func (m *C2CPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2CPushRequest.Unmarshal(m, b)
+}
+func (m *C2CPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2CPushRequest.Marshal(b, m, deterministic)
+}
+func (m *C2CPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2CPushRequest.Merge(m, src)
+}
+func (m *C2CPushRequest) XXX_Size() int {
+	return xxx_messageInfo_C2CPushRequest.Size(m)
+}
+func (m *C2CPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2CPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2CPushRequest proto.InternalMessageInfo
+
+func (m *C2CPushRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *C2CPushRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *C2CPushRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *C2CPushRequest) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *C2CPushRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2CPushRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *C2CPushRequest) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
+}
+
+func (m *C2CPushRequest) GetContentType() ContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return ContentType_TEXT
+}
+
+func (m *C2CPushRequest) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// 推送给其他群成员消息协议
+type C2GPushRequest struct {
+	From                 string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string      `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Group                string      `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Content              string      `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	MsgId                int64       `protobuf:"varint,5,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64       `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64       `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConvSeq              int64       `protobuf:"varint,8,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	MsgType              int32       `protobuf:"varint,9,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	ContentType          ContentType `protobuf:"varint,10,opt,name=content_type,json=contentType,proto3,enum=push.ContentType" json:"content_type,omitempty"`
+	Payload              *Payload    `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *C2GPushRequest) Reset()         { *m = C2GPushRequest{} }
+func (m *C2GPushRequest) String() string { return proto.CompactTextString(m) }
+func (*C2GPushRequest) ProtoMessage()    {}
+func (*C2GPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{10}
+}
+
+func (m *C2GPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_C2GPushRequest.Unmarshal(m, b)
+}
+func (m *C2GPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_C2GPushRequest.Marshal(b, m, deterministic)
+}
+func (m *C2GPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_C2GPushRequest.Merge(m, src)
+}
+func (m *C2GPushRequest) XXX_Size() int {
+	return xxx_messageInfo_C2GPushRequest.Size(m)
+}
+func (m *C2GPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_C2GPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_C2GPushRequest proto.InternalMessageInfo
+
+func (m *C2GPushRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *C2GPushRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *C2GPushRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *C2GPushRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *C2GPushRequest) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *C2GPushRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *C2GPushRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *C2GPushRequest) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
+}
+
+func (m *C2GPushRequest) GetMsgType() int32 {
+	if m != nil {
+		return m.MsgType
+	}
+	return 0
+}
+
+func (m *C2GPushRequest) GetContentType() ContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return ContentType_TEXT
+}
+
+func (m *C2GPushRequest) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// 批量推送群消息
+type BatchPushRequest struct {
+	To                   []string        `protobuf:"bytes,1,rep,name=to,proto3" json:"to,omitempty"`
+	Msg                  *C2GPushRequest `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ts                   int64           `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64           `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchPushRequest) Reset()         { *m = BatchPushRequest{} }
+func (m *BatchPushRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchPushRequest) ProtoMessage()    {}
+func (*BatchPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{11}
+}
+
+func (m *BatchPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchPushRequest.Unmarshal(m, b)
+}
+func (m *BatchPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchPushRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPushRequest.Merge(m, src)
+}
+func (m *BatchPushRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchPushRequest.Size(m)
+}
+func (m *BatchPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPushRequest proto.InternalMessageInfo
+
+func (m *BatchPushRequest) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *BatchPushRequest) GetMsg() *C2GPushRequest {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *BatchPushRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *BatchPushRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 推送给客户端的撤回通知，客户端据此把消息替换为撤回提示
+type RecallNotice struct {
+	Operator             string   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Group                string   `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	MsgId                int64    `protobuf:"varint,5,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Ts                   int64    `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64    `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecallNotice) Reset()         { *m = RecallNotice{} }
+func (m *RecallNotice) String() string { return proto.CompactTextString(m) }
+func (*RecallNotice) ProtoMessage()    {}
+func (*RecallNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{12}
+}
+
+func (m *RecallNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallNotice.Unmarshal(m, b)
+}
+func (m *RecallNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallNotice.Marshal(b, m, deterministic)
+}
+func (m *RecallNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallNotice.Merge(m, src)
+}
+func (m *RecallNotice) XXX_Size() int {
+	return xxx_messageInfo_RecallNotice.Size(m)
+}
+func (m *RecallNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallNotice proto.InternalMessageInfo
+
+func (m *RecallNotice) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *RecallNotice) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RecallNotice) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *RecallNotice) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *RecallNotice) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *RecallNotice) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *RecallNotice) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type RecallPushRequest struct {
+	To                   []string      `protobuf:"bytes,1,rep,name=to,proto3" json:"to,omitempty"`
+	Notice               *RecallNotice `protobuf:"bytes,2,opt,name=notice,proto3" json:"notice,omitempty"`
+	Ts                   int64         `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64         `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RecallPushRequest) Reset()         { *m = RecallPushRequest{} }
+func (m *RecallPushRequest) String() string { return proto.CompactTextString(m) }
+func (*RecallPushRequest) ProtoMessage()    {}
+func (*RecallPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{13}
+}
+
+func (m *RecallPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallPushRequest.Unmarshal(m, b)
+}
+func (m *RecallPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallPushRequest.Marshal(b, m, deterministic)
+}
+func (m *RecallPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallPushRequest.Merge(m, src)
+}
+func (m *RecallPushRequest) XXX_Size() int {
+	return xxx_messageInfo_RecallPushRequest.Size(m)
+}
+func (m *RecallPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallPushRequest proto.InternalMessageInfo
+
+func (m *RecallPushRequest) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *RecallPushRequest) GetNotice() *RecallNotice {
+	if m != nil {
+		return m.Notice
+	}
+	return nil
+}
+
+func (m *RecallPushRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *RecallPushRequest) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ReadCount struct {
+	MsgId                int64    `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	ReadCount            int32    `protobuf:"varint,2,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`
+	Total                int32    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadCount) Reset()         { *m = ReadCount{} }
+func (m *ReadCount) String() string { return proto.CompactTextString(m) }
+func (*ReadCount) ProtoMessage()    {}
+func (*ReadCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{14}
+}
+
+func (m *ReadCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadCount.Unmarshal(m, b)
+}
+func (m *ReadCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadCount.Marshal(b, m, deterministic)
+}
+func (m *ReadCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadCount.Merge(m, src)
+}
+func (m *ReadCount) XXX_Size() int {
+	return xxx_messageInfo_ReadCount.Size(m)
+}
+func (m *ReadCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadCount proto.InternalMessageInfo
+
+func (m *ReadCount) GetMsgId() int64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *ReadCount) GetReadCount() int32 {
+	if m != nil {
+		return m.ReadCount
+	}
+	return 0
+}
+
+func (m *ReadCount) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// 推送给发送者所有设备的已读回执
+type ReadReceipt struct {
+	Reader               string       `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	To                   string       `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Group                string       `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	ConvSeq              int64        `protobuf:"varint,4,opt,name=conv_seq,json=convSeq,proto3" json:"conv_seq,omitempty"`
+	MsgIds               []int64      `protobuf:"varint,5,rep,packed,name=msg_ids,json=msgIds,proto3" json:"msg_ids,omitempty"`
+	Counts               []*ReadCount `protobuf:"bytes,6,rep,name=counts,proto3" json:"counts,omitempty"`
+	Ts                   int64        `protobuf:"varint,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64        `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReadReceipt) Reset()         { *m = ReadReceipt{} }
+func (m *ReadReceipt) String() string { return proto.CompactTextString(m) }
+func (*ReadReceipt) ProtoMessage()    {}
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{15}
+}
+
+func (m *ReadReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadReceipt.Unmarshal(m, b)
+}
+func (m *ReadReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadReceipt.Marshal(b, m, deterministic)
+}
+func (m *ReadReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadReceipt.Merge(m, src)
+}
+func (m *ReadReceipt) XXX_Size() int {
+	return xxx_messageInfo_ReadReceipt.Size(m)
+}
+func (m *ReadReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadReceipt proto.InternalMessageInfo
+
+func (m *ReadReceipt) GetReader() string {
+	if m != nil {
+		return m.Reader
+	}
+	return ""
+}
+
+func (m *ReadReceipt) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ReadReceipt) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ReadReceipt) GetConvSeq() int64 {
+	if m != nil {
+		return m.ConvSeq
+	}
+	return 0
+}
+
+func (m *ReadReceipt) GetMsgIds() []int64 {
+	if m != nil {
+		return m.MsgIds
+	}
+	return nil
+}
+
+func (m *ReadReceipt) GetCounts() []*ReadCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *ReadReceipt) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+func (m *ReadReceipt) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 推送给订阅者的在线状态变化
+type PresenceNotice struct {
+	Uid                  string        `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	State                PresenceState `protobuf:"varint,2,opt,name=state,proto3,enum=push.PresenceState" json:"state,omitempty"`
+	LastSeen             int64         `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Ts                   int64         `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64         `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PresenceNotice) Reset()         { *m = PresenceNotice{} }
+func (m *PresenceNotice) String() string { return proto.CompactTextString(m) }
+func (*PresenceNotice) ProtoMessage()    {}
+func (*PresenceNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{16}
+}
+
+func (m *PresenceNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PresenceNotice.Unmarshal(m, b)
+}
+func (m *PresenceNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PresenceNotice.Marshal(b, m, deterministic)
+}
+func (m *PresenceNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresenceNotice.Merge(m, src)
+}
+func (m *PresenceNotice) XXX_Size() int {
+	return xxx_messageInfo_PresenceNotice.Size(m)
+}
+func (m *PresenceNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresenceNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresenceNotice proto.InternalMessageInfo
+
+func (m *PresenceNotice) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PresenceNotice) GetState() PresenceState {
+	if m != nil {
+		return m.State
+	}
+	return PresenceState_OFFLINE
+}
+
+func (m *PresenceNotice) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *PresenceNotice) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *KickOutResponse) GetSeq() int64 {
+func (m *PresenceNotice) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-// 推送给接收者的协议
-type C2CPushRequest struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Content              string   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	MsgId                int64    `protobuf:"varint,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,5,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PresencePushRequest struct {
+	To                   []string        `protobuf:"bytes,1,rep,name=to,proto3" json:"to,omitempty"`
+	Notice               *PresenceNotice `protobuf:"bytes,2,opt,name=notice,proto3" json:"notice,omitempty"`
+	Ts                   int64           `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64           `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *C2CPushRequest) Reset()         { *m = C2CPushRequest{} }
-func (m *C2CPushRequest) String() string { return proto.CompactTextString(m) }
-func (*C2CPushRequest) ProtoMessage()    {}
-func (*C2CPushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1e4bfd2e9d102bb, []int{3}
+func (m *PresencePushRequest) Reset()         { *m = PresencePushRequest{} }
+func (m *PresencePushRequest) String() string { return proto.CompactTextString(m) }
+func (*PresencePushRequest) ProtoMessage()    {}
+func (*PresencePushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{17}
 }
 
-func (m *C2CPushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2CPushRequest.Unmarshal(m, b)
+func (m *PresencePushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PresencePushRequest.Unmarshal(m, b)
 }
-func (m *C2CPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2CPushRequest.Marshal(b, m, deterministic)
+func (m *PresencePushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PresencePushRequest.Marshal(b, m, deterministic)
 }
-func (m *C2CPushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2CPushRequest.Merge(m, src)
+func (m *PresencePushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresencePushRequest.Merge(m, src)
 }
-func (m *C2CPushRequest) XXX_Size() int {
-	return xxx_messageInfo_C2CPushRequest.Size(m)
+func (m *PresencePushRequest) XXX_Size() int {
+	return xxx_messageInfo_PresencePushRequest.Size(m)
 }
-func (m *C2CPushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2CPushRequest.DiscardUnknown(m)
+func (m *PresencePushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresencePushRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2CPushRequest proto.InternalMessageInfo
-
-func (m *C2CPushRequest) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
-}
+var xxx_messageInfo_PresencePushRequest proto.InternalMessageInfo
 
-func (m *C2CPushRequest) GetTo() string {
+func (m *PresencePushRequest) GetTo() []string {
 	if m != nil {
 		return m.To
 	}
-	return ""
-}
-
-func (m *C2CPushRequest) GetContent() string {
-	if m != nil {
-		return m.Content
-	}
-	return ""
+	return nil
 }
 
-func (m *C2CPushRequest) GetMsgId() int64 {
+func (m *PresencePushRequest) GetNotice() *PresenceNotice {
 	if m != nil {
-		return m.MsgId
+		return m.Notice
 	}
-	return 0
+	return nil
 }
 
-func (m *C2CPushRequest) GetTs() int64 {
+func (m *PresencePushRequest) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2CPushRequest) GetSeq() int64 {
+func (m *PresencePushRequest) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
 	return 0
 }
 
-// 推送给其他群成员消息协议
-type C2GPushRequest struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
-	Content              string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	MsgId                int64    `protobuf:"varint,5,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
-	Ts                   int64    `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
-	Seq                  int64    `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// 推送给接收者的临时信号，客户端不需要应答
+type SignalNotice struct {
+	From                 string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string     `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Group                string     `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Type                 SignalType `protobuf:"varint,4,opt,name=type,proto3,enum=push.SignalType" json:"type,omitempty"`
+	Data                 string     `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Ts                   int64      `protobuf:"varint,6,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64      `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *C2GPushRequest) Reset()         { *m = C2GPushRequest{} }
-func (m *C2GPushRequest) String() string { return proto.CompactTextString(m) }
-func (*C2GPushRequest) ProtoMessage()    {}
-func (*C2GPushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1e4bfd2e9d102bb, []int{4}
+func (m *SignalNotice) Reset()         { *m = SignalNotice{} }
+func (m *SignalNotice) String() string { return proto.CompactTextString(m) }
+func (*SignalNotice) ProtoMessage()    {}
+func (*SignalNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{18}
 }
 
-func (m *C2GPushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_C2GPushRequest.Unmarshal(m, b)
+func (m *SignalNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalNotice.Unmarshal(m, b)
 }
-func (m *C2GPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_C2GPushRequest.Marshal(b, m, deterministic)
+func (m *SignalNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalNotice.Marshal(b, m, deterministic)
 }
-func (m *C2GPushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_C2GPushRequest.Merge(m, src)
+func (m *SignalNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalNotice.Merge(m, src)
 }
-func (m *C2GPushRequest) XXX_Size() int {
-	return xxx_messageInfo_C2GPushRequest.Size(m)
+func (m *SignalNotice) XXX_Size() int {
+	return xxx_messageInfo_SignalNotice.Size(m)
 }
-func (m *C2GPushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_C2GPushRequest.DiscardUnknown(m)
+func (m *SignalNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalNotice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_C2GPushRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignalNotice proto.InternalMessageInfo
 
-func (m *C2GPushRequest) GetFrom() string {
+func (m *SignalNotice) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *C2GPushRequest) GetTo() string {
+func (m *SignalNotice) GetTo() string {
 	if m != nil {
 		return m.To
 	}
 	return ""
 }
 
-func (m *C2GPushRequest) GetGroup() string {
+func (m *SignalNotice) GetGroup() string {
 	if m != nil {
 		return m.Group
 	}
 	return ""
 }
 
-func (m *C2GPushRequest) GetContent() string {
+func (m *SignalNotice) GetType() SignalType {
 	if m != nil {
-		return m.Content
+		return m.Type
+	}
+	return SignalType_TYPING
+}
+
+func (m *SignalNotice) GetData() string {
+	if m != nil {
+		return m.Data
 	}
 	return ""
 }
 
-func (m *C2GPushRequest) GetMsgId() int64 {
+func (m *SignalNotice) GetTs() int64 {
 	if m != nil {
-		return m.MsgId
+		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2GPushRequest) GetTs() int64 {
+func (m *SignalNotice) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type SignalPushRequest struct {
+	To                   []string      `protobuf:"bytes,1,rep,name=to,proto3" json:"to,omitempty"`
+	Notice               *SignalNotice `protobuf:"bytes,2,opt,name=notice,proto3" json:"notice,omitempty"`
+	Ts                   int64         `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	Seq                  int64         `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignalPushRequest) Reset()         { *m = SignalPushRequest{} }
+func (m *SignalPushRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalPushRequest) ProtoMessage()    {}
+func (*SignalPushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1e4bfd2e9d102bb, []int{19}
+}
+
+func (m *SignalPushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalPushRequest.Unmarshal(m, b)
+}
+func (m *SignalPushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalPushRequest.Marshal(b, m, deterministic)
+}
+func (m *SignalPushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalPushRequest.Merge(m, src)
+}
+func (m *SignalPushRequest) XXX_Size() int {
+	return xxx_messageInfo_SignalPushRequest.Size(m)
+}
+func (m *SignalPushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalPushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalPushRequest proto.InternalMessageInfo
+
+func (m *SignalPushRequest) GetTo() []string {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *SignalPushRequest) GetNotice() *SignalNotice {
+	if m != nil {
+		return m.Notice
+	}
+	return nil
+}
+
+func (m *SignalPushRequest) GetTs() int64 {
 	if m != nil {
 		return m.Ts
 	}
 	return 0
 }
 
-func (m *C2GPushRequest) GetSeq() int64 {
+func (m *SignalPushRequest) GetSeq() int64 {
 	if m != nil {
 		return m.Seq
 	}
@@ -372,44 +1607,121 @@ func (m *C2GPushRequest) GetSeq() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("push.ContentType", ContentType_name, ContentType_value)
+	proto.RegisterEnum("push.PresenceState", PresenceState_name, PresenceState_value)
+	proto.RegisterEnum("push.SignalType", SignalType_name, SignalType_value)
 	proto.RegisterEnum("push.KickOutRequest_Reason", KickOutRequest_Reason_name, KickOutRequest_Reason_value)
 	proto.RegisterType((*Response)(nil), "push.Response")
+	proto.RegisterType((*Payload)(nil), "push.Payload")
+	proto.RegisterType((*TextPayload)(nil), "push.TextPayload")
+	proto.RegisterType((*ImagePayload)(nil), "push.ImagePayload")
+	proto.RegisterType((*FilePayload)(nil), "push.FilePayload")
+	proto.RegisterType((*LocationPayload)(nil), "push.LocationPayload")
+	proto.RegisterType((*CustomPayload)(nil), "push.CustomPayload")
 	proto.RegisterType((*KickOutRequest)(nil), "push.KickOutRequest")
 	proto.RegisterType((*KickOutResponse)(nil), "push.KickOutResponse")
 	proto.RegisterType((*C2CPushRequest)(nil), "push.C2CPushRequest")
 	proto.RegisterType((*C2GPushRequest)(nil), "push.C2GPushRequest")
+	proto.RegisterType((*BatchPushRequest)(nil), "push.BatchPushRequest")
+	proto.RegisterType((*RecallNotice)(nil), "push.RecallNotice")
+	proto.RegisterType((*RecallPushRequest)(nil), "push.RecallPushRequest")
+	proto.RegisterType((*ReadCount)(nil), "push.ReadCount")
+	proto.RegisterType((*ReadReceipt)(nil), "push.ReadReceipt")
+	proto.RegisterType((*PresenceNotice)(nil), "push.PresenceNotice")
+	proto.RegisterType((*PresencePushRequest)(nil), "push.PresencePushRequest")
+	proto.RegisterType((*SignalNotice)(nil), "push.SignalNotice")
+	proto.RegisterType((*SignalPushRequest)(nil), "push.SignalPushRequest")
 }
 
 func init() { proto.RegisterFile("push.proto", fileDescriptor_d1e4bfd2e9d102bb) }
 
 var fileDescriptor_d1e4bfd2e9d102bb = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x4a, 0xc3, 0x30,
-	0x14, 0x87, 0xed, 0xbf, 0xd4, 0x9e, 0x41, 0x37, 0xc2, 0x26, 0x75, 0x57, 0xa3, 0x57, 0xbb, 0x90,
-	0x09, 0x1b, 0xf8, 0x02, 0x43, 0xea, 0x50, 0x9c, 0x44, 0xef, 0x87, 0x6e, 0x71, 0x2b, 0xd2, 0xa6,
-	0x6b, 0x12, 0x7d, 0x04, 0x9f, 0xc2, 0x6b, 0x5f, 0x53, 0x9a, 0xa6, 0xd5, 0x56, 0x14, 0xbd, 0x3b,
-	0xe7, 0xc0, 0xf7, 0xcb, 0xc7, 0xc9, 0x01, 0xc8, 0x24, 0xdf, 0x4d, 0xb2, 0x9c, 0x09, 0x86, 0xed,
-	0xa2, 0x0e, 0x4f, 0xe0, 0x90, 0x50, 0x9e, 0xb1, 0x94, 0x53, 0xec, 0x83, 0x29, 0x78, 0x60, 0x8c,
-	0x8c, 0xb1, 0x45, 0x4c, 0xc1, 0x71, 0x0f, 0x2c, 0x4e, 0xf7, 0x81, 0xa9, 0x06, 0x45, 0x19, 0xbe,
-	0x80, 0x7f, 0x19, 0xaf, 0x9f, 0x96, 0x52, 0x10, 0xba, 0x97, 0x94, 0x0b, 0x7c, 0x04, 0x28, 0xa7,
-	0xf7, 0x9c, 0xa5, 0x8a, 0x73, 0x88, 0xee, 0x74, 0x96, 0xd9, 0xce, 0xb2, 0xea, 0xac, 0x62, 0x22,
-	0xe3, 0x4d, 0x60, 0x8f, 0x8c, 0xb1, 0x47, 0x8a, 0x32, 0x3c, 0x06, 0x44, 0x4a, 0xba, 0x0b, 0x9d,
-	0xe5, 0xdd, 0xc5, 0x39, 0x59, 0x5d, 0x2d, 0xa3, 0xc5, 0x75, 0xef, 0x20, 0x9c, 0x41, 0xb7, 0x7e,
-	0xf8, 0xcf, 0xb6, 0xaf, 0x06, 0xf8, 0xf3, 0xe9, 0xfc, 0x46, 0xf2, 0x5d, 0xa5, 0x8b, 0xc1, 0x7e,
-	0xcc, 0x59, 0xa2, 0x30, 0x8f, 0xa8, 0x5a, 0x05, 0x31, 0xc5, 0x79, 0xc4, 0x14, 0x0c, 0x07, 0xe0,
-	0xae, 0x59, 0x2a, 0x68, 0x2a, 0x94, 0xae, 0x47, 0xaa, 0x16, 0x0f, 0x00, 0x25, 0x7c, 0xbb, 0xd2,
-	0xd6, 0x16, 0x71, 0x12, 0xbe, 0x5d, 0x6c, 0xb4, 0x89, 0xd3, 0x36, 0x41, 0x9f, 0x26, 0x6f, 0xca,
-	0x24, 0xfa, 0xaf, 0x49, 0x1f, 0x9c, 0x6d, 0xce, 0x64, 0xa6, 0x3d, 0xca, 0xe6, 0xab, 0x9f, 0xfd,
-	0x93, 0x9f, 0xf3, 0xdd, 0x0f, 0xb5, 0xfd, 0xdc, 0xda, 0x6f, 0xfa, 0x6e, 0x40, 0xa7, 0x90, 0xbb,
-	0xa5, 0xf9, 0x73, 0xbc, 0xa6, 0xf8, 0x0c, 0x5c, 0xbd, 0x6e, 0xdc, 0x9f, 0xa8, 0x9b, 0x69, 0x7e,
-	0xfb, 0x70, 0xd0, 0x9a, 0xea, 0x3f, 0x39, 0x05, 0x57, 0x2f, 0xbc, 0xe2, 0x9a, 0xfb, 0x1f, 0xfa,
-	0xe5, 0xb4, 0x09, 0x44, 0x4d, 0x20, 0xfa, 0x05, 0x78, 0x40, 0xea, 0x78, 0x67, 0x1f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xf7, 0x1b, 0xb4, 0x98, 0xca, 0x02, 0x00, 0x00,
+	// 1280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xff, 0xa9, 0xa1, 0x2d, 0x33, 0x1b, 0x27, 0x51, 0xd2, 0x16, 0x48, 0xd9, 0x9f, 0xb8,
+	0x46, 0x9a, 0x26, 0x72, 0xff, 0xae, 0x8e, 0x6a, 0x2b, 0x42, 0x1d, 0xcb, 0x58, 0x29, 0x48, 0x73,
+	0x12, 0x68, 0x72, 0x23, 0x11, 0xa5, 0x48, 0x99, 0x5c, 0xa5, 0x71, 0x81, 0x1e, 0x7a, 0xef, 0x2b,
+	0x14, 0xe8, 0xa1, 0x4f, 0xd0, 0x7b, 0x5f, 0xa0, 0x2f, 0xd1, 0x87, 0xe8, 0x0b, 0x14, 0xfb, 0x43,
+	0xf1, 0xc7, 0x89, 0x61, 0xe7, 0xb6, 0x33, 0x3b, 0x33, 0x3b, 0xf3, 0xcd, 0x1f, 0x09, 0xb0, 0x58,
+	0xe6, 0xb3, 0x07, 0x8b, 0x2c, 0xa5, 0x29, 0xd2, 0xd9, 0xd9, 0xbb, 0x0f, 0x36, 0x26, 0xf9, 0x22,
+	0x4d, 0x72, 0x82, 0xda, 0xa0, 0xd2, 0xbc, 0xa3, 0xdc, 0x55, 0xb6, 0x35, 0xac, 0xd2, 0x1c, 0xb9,
+	0xa0, 0xe5, 0xe4, 0xb4, 0xa3, 0x72, 0x06, 0x3b, 0x7a, 0xff, 0x29, 0x60, 0x1d, 0xfb, 0x67, 0x71,
+	0xea, 0x87, 0xe8, 0x1e, 0xe8, 0x94, 0xbc, 0xa6, 0x5c, 0xde, 0xe9, 0x5e, 0x7b, 0xc0, 0x4d, 0x8f,
+	0xc9, 0x6b, 0x2a, 0x05, 0x9e, 0xac, 0x61, 0x2e, 0x80, 0x76, 0xc0, 0x88, 0xe6, 0xfe, 0x94, 0x70,
+	0x43, 0x4e, 0x17, 0x09, 0xc9, 0x01, 0x63, 0x95, 0xa2, 0x42, 0x84, 0x19, 0x7d, 0x19, 0xc5, 0xa4,
+	0xa3, 0x55, 0x8d, 0x1e, 0x44, 0x71, 0x45, 0x92, 0x0b, 0xa0, 0x5d, 0xb0, 0xe3, 0x34, 0xf0, 0x69,
+	0x94, 0x26, 0x1d, 0x9d, 0x0b, 0xdf, 0x10, 0xc2, 0x87, 0x92, 0x5b, 0x2a, 0xac, 0x04, 0xd1, 0xe7,
+	0x60, 0x06, 0xcb, 0x9c, 0xa6, 0xf3, 0x8e, 0xc1, 0x55, 0xae, 0x0b, 0x95, 0x1e, 0xe7, 0x95, 0x0a,
+	0x52, 0xe8, 0xb1, 0x09, 0xfa, 0x49, 0x1a, 0x9e, 0x79, 0x1f, 0x82, 0x53, 0x89, 0x0b, 0xa1, 0x4a,
+	0xe0, 0x2d, 0x11, 0xa3, 0xf7, 0xb7, 0x02, 0xeb, 0xd5, 0x88, 0x18, 0x76, 0xcb, 0x2c, 0x96, 0x32,
+	0xec, 0x88, 0x3e, 0x82, 0x0d, 0x3a, 0x5b, 0xce, 0x4f, 0x12, 0x3f, 0x8a, 0x27, 0xec, 0x4e, 0xe5,
+	0x77, 0xeb, 0x2b, 0xe6, 0xb3, 0x2c, 0x46, 0x5b, 0x60, 0xfc, 0x14, 0x85, 0x74, 0xc6, 0x01, 0x30,
+	0xb0, 0x20, 0xd0, 0x4d, 0x30, 0x67, 0x24, 0x9a, 0xce, 0x28, 0x0f, 0xd5, 0xc0, 0x92, 0x62, 0x9e,
+	0xe4, 0xd1, 0xcf, 0x84, 0x47, 0xa3, 0x61, 0x7e, 0x46, 0xef, 0x41, 0x6b, 0x1e, 0xcd, 0xc9, 0x84,
+	0x9e, 0x2d, 0x48, 0xc7, 0xe4, 0x4f, 0xd8, 0x8c, 0x31, 0x3e, 0x5b, 0x10, 0x74, 0x0b, 0xac, 0x93,
+	0x38, 0x3d, 0x99, 0x44, 0x61, 0xc7, 0xe2, 0x57, 0x26, 0x23, 0x07, 0xa1, 0xf7, 0x0b, 0x38, 0x15,
+	0x94, 0xdf, 0xe0, 0x3d, 0x02, 0x3d, 0xf1, 0xe7, 0x44, 0x3a, 0xcd, 0xcf, 0xab, 0xe7, 0xb5, 0xb7,
+	0x3d, 0xaf, 0xbf, 0xfd, 0x79, 0xa3, 0xf6, 0x3c, 0x81, 0xcd, 0x46, 0xde, 0xd0, 0x1d, 0xb0, 0x63,
+	0x9f, 0x46, 0x74, 0x19, 0x12, 0xee, 0x87, 0x82, 0x57, 0x34, 0x7a, 0x1f, 0x5a, 0x71, 0x9a, 0x4c,
+	0xc5, 0xa5, 0xca, 0x2f, 0x4b, 0x06, 0xea, 0x80, 0xe5, 0x87, 0x61, 0x46, 0xf2, 0x9c, 0x7b, 0xd6,
+	0xc2, 0x05, 0xe9, 0x7d, 0x03, 0x1b, 0xb5, 0x5c, 0xf3, 0x54, 0x9e, 0x2d, 0xc4, 0x03, 0x2c, 0x95,
+	0xcc, 0x49, 0x04, 0x7a, 0xe8, 0x53, 0xbf, 0x88, 0x94, 0x9d, 0xbd, 0x3f, 0x14, 0x68, 0x7f, 0x1f,
+	0x05, 0x3f, 0x0e, 0x97, 0x14, 0x93, 0xd3, 0x25, 0xc9, 0x29, 0xcb, 0x49, 0x46, 0xfc, 0x3c, 0x4d,
+	0xb8, 0xb2, 0x81, 0x25, 0x25, 0x9b, 0x48, 0x6d, 0x36, 0x91, 0xb6, 0x6a, 0x22, 0x0e, 0x6e, 0x14,
+	0x4a, 0x70, 0xd8, 0x91, 0x81, 0x16, 0x92, 0x57, 0x51, 0x40, 0x4a, 0x64, 0x6c, 0xc1, 0x18, 0x84,
+	0xde, 0x27, 0x60, 0x62, 0x61, 0x7a, 0x13, 0x9c, 0xe1, 0xf8, 0xc9, 0x3e, 0x9e, 0x1c, 0x0e, 0xfb,
+	0x83, 0x23, 0x77, 0x0d, 0x01, 0x98, 0x87, 0xc3, 0xfe, 0xf0, 0xd9, 0xd8, 0x55, 0xbc, 0x5d, 0xd8,
+	0x5c, 0x79, 0x78, 0xe9, 0x7e, 0xfe, 0x55, 0x85, 0x76, 0xaf, 0xdb, 0x3b, 0x5e, 0xe6, 0xb3, 0x22,
+	0x2e, 0x04, 0xfa, 0xcb, 0x2c, 0x9d, 0x17, 0x90, 0xb0, 0x33, 0x37, 0x94, 0x4a, 0x40, 0x54, 0x9a,
+	0x32, 0x84, 0x83, 0x34, 0xa1, 0x24, 0xa1, 0x05, 0xc2, 0x92, 0x44, 0x37, 0xc0, 0x9c, 0xe7, 0xd3,
+	0x89, 0x0c, 0x4f, 0xc3, 0xc6, 0x3c, 0x9f, 0x0e, 0x42, 0xe9, 0x89, 0xd1, 0xf4, 0xc4, 0x2c, 0x41,
+	0xb9, 0x0d, 0x76, 0x90, 0x26, 0xaf, 0x26, 0x8c, 0x6d, 0x71, 0x36, 0xb3, 0xf9, 0x6a, 0x44, 0x4e,
+	0xd1, 0x97, 0xb0, 0x2e, 0xcd, 0x8b, 0xaa, 0xb2, 0xef, 0x2a, 0xdb, 0xed, 0x62, 0x36, 0xf4, 0xc4,
+	0x0d, 0x2b, 0x2f, 0xec, 0x04, 0x25, 0x81, 0xee, 0x81, 0xb5, 0x10, 0x59, 0xee, 0xb4, 0x78, 0xb3,
+	0x6f, 0x08, 0x05, 0x99, 0x7a, 0x5c, 0xdc, 0x7a, 0x7f, 0x71, 0x0c, 0xfa, 0x57, 0xc5, 0x60, 0x0b,
+	0x8c, 0x69, 0x96, 0x2e, 0x17, 0x12, 0x01, 0x41, 0x54, 0x91, 0xd1, 0xdf, 0x86, 0x8c, 0x71, 0x1e,
+	0x19, 0xb3, 0x89, 0x8c, 0xf5, 0x66, 0x64, 0xec, 0x3a, 0x32, 0xb7, 0xc1, 0x66, 0x36, 0x39, 0x2a,
+	0x2d, 0x5e, 0x85, 0xd6, 0x3c, 0x9f, 0xf2, 0xf0, 0x9b, 0xa0, 0xc1, 0x55, 0x41, 0x73, 0x2e, 0x04,
+	0x2d, 0x06, 0xf7, 0xb1, 0x4f, 0x83, 0x59, 0x15, 0x35, 0x81, 0x90, 0x72, 0x57, 0x93, 0x08, 0x7d,
+	0x0a, 0xda, 0x3c, 0x9f, 0xca, 0xa9, 0xbf, 0x25, 0x5f, 0xae, 0x01, 0x8d, 0x99, 0x80, 0x84, 0x40,
+	0x6b, 0x42, 0xa0, 0x97, 0x65, 0xfa, 0xbb, 0x02, 0xeb, 0x98, 0x04, 0x7e, 0x1c, 0x1f, 0xa5, 0x34,
+	0x0a, 0x08, 0x1b, 0x0e, 0xe9, 0x82, 0x64, 0x3e, 0x4d, 0x33, 0x99, 0xa4, 0x15, 0xbd, 0x4a, 0x9e,
+	0x7a, 0x2e, 0x79, 0xda, 0xf9, 0xe4, 0xe9, 0xd5, 0xe4, 0xbd, 0x6b, 0x8a, 0xbc, 0x53, 0xb8, 0x26,
+	0xdc, 0xbb, 0x08, 0x8e, 0x1d, 0x30, 0x13, 0xee, 0x7d, 0x7d, 0x0f, 0x56, 0xe3, 0xc2, 0x52, 0xe2,
+	0x12, 0x90, 0x3c, 0x87, 0x16, 0x26, 0x7e, 0xd8, 0x4b, 0x97, 0xb5, 0xda, 0x52, 0xaa, 0x8e, 0x7f,
+	0x00, 0x90, 0x11, 0x3f, 0x9c, 0x04, 0x4c, 0x88, 0xbf, 0x6a, 0xe0, 0x56, 0xb6, 0xd2, 0xda, 0x02,
+	0x83, 0xa6, 0xd4, 0x8f, 0x8b, 0x5d, 0xc3, 0x09, 0xef, 0x1f, 0x05, 0x1c, 0x66, 0x19, 0x93, 0x80,
+	0x44, 0x8b, 0x62, 0xce, 0x85, 0xa4, 0x00, 0x5a, 0x52, 0x97, 0xec, 0x87, 0x6a, 0xf1, 0xea, 0xf5,
+	0xe2, 0xbd, 0x05, 0x96, 0x70, 0x9a, 0x0d, 0x06, 0x6d, 0x5b, 0xc3, 0x26, 0xf7, 0x3a, 0x47, 0xf7,
+	0xc0, 0xe4, 0x1e, 0x33, 0xcc, 0xb5, 0x6d, 0xa7, 0xbb, 0x59, 0x00, 0x25, 0x1d, 0xc7, 0xf2, 0x5a,
+	0xa2, 0x64, 0x35, 0x51, 0xb2, 0x4b, 0x94, 0x7e, 0x53, 0xa0, 0x7d, 0x9c, 0x91, 0x9c, 0x24, 0x01,
+	0x91, 0xa5, 0x23, 0xa7, 0xaf, 0x52, 0x4e, 0xdf, 0xcf, 0xc0, 0xc8, 0xa9, 0x4f, 0x45, 0x5e, 0xda,
+	0xc5, 0x47, 0x41, 0xa1, 0x36, 0x62, 0x57, 0x58, 0x48, 0xb0, 0x41, 0x1d, 0xfb, 0x39, 0x9d, 0xe4,
+	0x84, 0x24, 0x32, 0x3d, 0x36, 0x63, 0x8c, 0x08, 0x29, 0x26, 0xbf, 0xde, 0x74, 0xc7, 0x28, 0xdd,
+	0x59, 0xc2, 0xf5, 0xc2, 0xec, 0x45, 0x95, 0x72, 0xbf, 0x51, 0x29, 0x5b, 0x75, 0x8f, 0xae, 0x5c,
+	0x2b, 0x7f, 0x2a, 0xb0, 0x3e, 0x8a, 0xa6, 0x89, 0x5f, 0xb4, 0xcf, 0xbb, 0xcf, 0xb7, 0x8f, 0xe5,
+	0xc2, 0xd4, 0x39, 0x54, 0xae, 0x70, 0x4c, 0xd8, 0xe6, 0xd3, 0xa4, 0xbe, 0x42, 0x8d, 0x72, 0x85,
+	0x5e, 0xae, 0x8b, 0x84, 0xa5, 0x77, 0xe8, 0xa2, 0x6a, 0x78, 0x97, 0x47, 0x66, 0xe7, 0x00, 0x9c,
+	0xca, 0x2c, 0x44, 0x36, 0xe8, 0xe3, 0xfd, 0x1f, 0xc6, 0xee, 0x1a, 0x6a, 0x81, 0x31, 0x78, 0xba,
+	0xd7, 0xdf, 0x77, 0x15, 0xc6, 0x3c, 0x18, 0x1c, 0xee, 0xbb, 0x2a, 0x5a, 0x07, 0xfb, 0x70, 0xd8,
+	0xdb, 0x1b, 0x0f, 0x86, 0x47, 0xae, 0xc6, 0x96, 0x6f, 0xef, 0xd9, 0x68, 0x3c, 0x7c, 0xea, 0xea,
+	0x3b, 0x5d, 0xd8, 0xa8, 0xd5, 0x0b, 0x72, 0xc0, 0x1a, 0x1e, 0x1c, 0x1c, 0x0e, 0x8e, 0xf6, 0xc5,
+	0x9a, 0x1e, 0x1e, 0xf1, 0x33, 0xb7, 0xb6, 0xf7, 0x7c, 0xef, 0x85, 0xab, 0xee, 0x7c, 0x0b, 0x50,
+	0x02, 0xc7, 0x64, 0xc6, 0x2f, 0x8e, 0x07, 0x47, 0x7d, 0x77, 0x0d, 0x21, 0x68, 0x8b, 0xf3, 0x64,
+	0x34, 0x1e, 0x1e, 0x1f, 0xef, 0x7f, 0xe7, 0x2a, 0x95, 0xd7, 0xd4, 0xee, 0xbf, 0x1a, 0x38, 0x0c,
+	0xa3, 0x11, 0xc9, 0xd8, 0x37, 0x02, 0xfa, 0x1a, 0x2c, 0xb9, 0xfa, 0x91, 0x2c, 0x95, 0xfa, 0xb7,
+	0xca, 0x9d, 0x1b, 0x0d, 0xae, 0xfc, 0x3e, 0xf8, 0x02, 0x2c, 0xb9, 0xfc, 0xd1, 0x6a, 0x3c, 0x57,
+	0xbf, 0x05, 0xee, 0xb4, 0x8b, 0xce, 0xab, 0x2a, 0xf4, 0xeb, 0x0a, 0xfd, 0x8b, 0x14, 0x76, 0xa1,
+	0xb5, 0x5a, 0x13, 0xe8, 0xa6, 0xb8, 0x6c, 0xee, 0x8d, 0x73, 0x4a, 0x5d, 0xd0, 0xaf, 0x22, 0xbf,
+	0xad, 0x3c, 0x54, 0xd0, 0x23, 0x30, 0xc5, 0x20, 0x45, 0xb7, 0xaa, 0x63, 0xf5, 0xa2, 0x67, 0x1e,
+	0xd6, 0xe7, 0xdc, 0xb5, 0x72, 0xca, 0x48, 0xd6, 0x39, 0x8d, 0xaf, 0xc0, 0x2e, 0xb2, 0x8c, 0x6e,
+	0xd7, 0x7b, 0xf2, 0xa2, 0x87, 0x1e, 0x81, 0x29, 0x12, 0x5d, 0xf8, 0x76, 0xae, 0xca, 0x9b, 0x2a,
+	0x27, 0x26, 0xff, 0x45, 0xdb, 0xfd, 0x7f, 0x00, 0x11, 0x2e, 0x97, 0x2a, 0xb0, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ *grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
@@ -422,6 +1734,12 @@ type PushServiceClient interface {
 	KickOut(ctx context.Context, in *KickOutRequest, opts ...grpc.CallOption) (*KickOutResponse, error)
 	C2CPush(ctx context.Context, in *C2CPushRequest, opts ...grpc.CallOption) (*Response, error)
 	C2GPush(ctx context.Context, in *C2GPushRequest, opts ...grpc.CallOption) (*Response, error)
+	BatchPush(ctx context.Context, in *BatchPushRequest, opts ...grpc.CallOption) (*Response, error)
+	Push(ctx context.Context, opts ...grpc.CallOption) (PushService_PushClient, error)
+	Recall(ctx context.Context, in *RecallPushRequest, opts ...grpc.CallOption) (*Response, error)
+	ReadReceipt(ctx context.Context, in *ReadReceipt, opts ...grpc.CallOption) (*Response, error)
+	Presence(ctx context.Context, in *PresencePushRequest, opts ...grpc.CallOption) (*Response, error)
+	Signal(ctx context.Context, in *SignalPushRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type pushServiceClient struct {
@@ -459,11 +1777,93 @@ func (c *pushServiceClient) C2GPush(ctx context.Context, in *C2GPushRequest, opt
 	return out, nil
 }
 
+func (c *pushServiceClient) BatchPush(ctx context.Context, in *BatchPushRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/push.PushService/BatchPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) Push(ctx context.Context, opts ...grpc.CallOption) (PushService_PushClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PushService_serviceDesc.Streams[0], "/push.PushService/Push", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pushServicePushClient{stream}
+	return x, nil
+}
+
+type PushService_PushClient interface {
+	Send(*BatchPushRequest) error
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type pushServicePushClient struct {
+	grpc.ClientStream
+}
+
+func (x *pushServicePushClient) Send(m *BatchPushRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pushServicePushClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pushServiceClient) Recall(ctx context.Context, in *RecallPushRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/push.PushService/Recall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ReadReceipt(ctx context.Context, in *ReadReceipt, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/push.PushService/ReadReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) Presence(ctx context.Context, in *PresencePushRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/push.PushService/Presence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) Signal(ctx context.Context, in *SignalPushRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/push.PushService/Signal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushServiceServer is the server API for PushService service.
 type PushServiceServer interface {
 	KickOut(context.Context, *KickOutRequest) (*KickOutResponse, error)
 	C2CPush(context.Context, *C2CPushRequest) (*Response, error)
 	C2GPush(context.Context, *C2GPushRequest) (*Response, error)
+	BatchPush(context.Context, *BatchPushRequest) (*Response, error)
+	Push(PushService_PushServer) error
+	Recall(context.Context, *RecallPushRequest) (*Response, error)
+	ReadReceipt(context.Context, *ReadReceipt) (*Response, error)
+	Presence(context.Context, *PresencePushRequest) (*Response, error)
+	Signal(context.Context, *SignalPushRequest) (*Response, error)
 }
 
 // UnimplementedPushServiceServer can be embedded to have forward compatible implementations.
@@ -479,6 +1879,24 @@ func (*UnimplementedPushServiceServer) C2CPush(ctx context.Context, req *C2CPush
 func (*UnimplementedPushServiceServer) C2GPush(ctx context.Context, req *C2GPushRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method C2GPush not implemented")
 }
+func (*UnimplementedPushServiceServer) BatchPush(ctx context.Context, req *BatchPushRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPush not implemented")
+}
+func (*UnimplementedPushServiceServer) Push(srv PushService_PushServer) error {
+	return status.Errorf(codes.Unimplemented, "method Push not implemented")
+}
+func (*UnimplementedPushServiceServer) Recall(ctx context.Context, req *RecallPushRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recall not implemented")
+}
+func (*UnimplementedPushServiceServer) ReadReceipt(ctx context.Context, req *ReadReceipt) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadReceipt not implemented")
+}
+func (*UnimplementedPushServiceServer) Presence(ctx context.Context, req *PresencePushRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Presence not implemented")
+}
+func (*UnimplementedPushServiceServer) Signal(ctx context.Context, req *SignalPushRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
 
 func RegisterPushServiceServer(s *grpc.Server, srv PushServiceServer) {
 	s.RegisterService(&_PushService_serviceDesc, srv)
@@ -538,6 +1956,122 @@ func _PushService_C2GPush_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_BatchPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).BatchPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.PushService/BatchPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).BatchPush(ctx, req.(*BatchPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_Push_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PushServiceServer).Push(&pushServicePushServer{stream})
+}
+
+type PushService_PushServer interface {
+	Send(*Response) error
+	Recv() (*BatchPushRequest, error)
+	grpc.ServerStream
+}
+
+type pushServicePushServer struct {
+	grpc.ServerStream
+}
+
+func (x *pushServicePushServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pushServicePushServer) Recv() (*BatchPushRequest, error) {
+	m := new(BatchPushRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PushService_Recall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Recall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.PushService/Recall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Recall(ctx, req.(*RecallPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ReadReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.PushService/ReadReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ReadReceipt(ctx, req.(*ReadReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_Presence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresencePushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Presence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.PushService/Presence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Presence(ctx, req.(*PresencePushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/push.PushService/Signal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).Signal(ctx, req.(*SignalPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PushService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "push.PushService",
 	HandlerType: (*PushServiceServer)(nil),
@@ -554,7 +2088,34 @@ var _PushService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "C2GPush",
 			Handler:    _PushService_C2GPush_Handler,
 		},
+		{
+			MethodName: "BatchPush",
+			Handler:    _PushService_BatchPush_Handler,
+		},
+		{
+			MethodName: "Recall",
+			Handler:    _PushService_Recall_Handler,
+		},
+		{
+			MethodName: "ReadReceipt",
+			Handler:    _PushService_ReadReceipt_Handler,
+		},
+		{
+			MethodName: "Presence",
+			Handler:    _PushService_Presence_Handler,
+		},
+		{
+			MethodName: "Signal",
+			Handler:    _PushService_Signal_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Push",
+			Handler:       _PushService_Push_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "push.proto",
 }
@@ -33,6 +33,67 @@ const (
 	C2GPushResponseMessage
 	C2SPullRequestMessage
 	C2SPullResponseMessage
+	PullBySeqRequestMessage
+	PullBySeqResponseMessage
+	CreateGroupRequestMessage
+	CreateGroupResponseMessage
+	RenameGroupRequestMessage
+	RenameGroupResponseMessage
+	DisbandGroupRequestMessage
+	DisbandGroupResponseMessage
+	JoinGroupRequestMessage
+	JoinGroupResponseMessage
+	LeaveGroupRequestMessage
+	LeaveGroupResponseMessage
+	InviteGroupMembersRequestMessage
+	InviteGroupMembersResponseMessage
+	RemoveGroupMembersRequestMessage
+	RemoveGroupMembersResponseMessage
+	ListGroupMembersRequestMessage
+	ListGroupMembersResponseMessage
+	ListUserGroupsRequestMessage
+	ListUserGroupsResponseMessage
+	MuteGroupRequestMessage
+	MuteGroupResponseMessage
+	SetGroupAdminRequestMessage
+	SetGroupAdminResponseMessage
+	TransferGroupOwnerRequestMessage
+	TransferGroupOwnerResponseMessage
+	RecallRequestMessage
+	RecallResponseMessage
+	RecallPushRequestMessage
+	MarkReadRequestMessage
+	MarkReadResponseMessage
+	ReadCountsRequestMessage
+	ReadCountsResponseMessage
+	ReadReceiptPushMessage
+	ListConversationsRequestMessage
+	ListConversationsResponseMessage
+	ClearUnreadRequestMessage
+	ClearUnreadResponseMessage
+	HistoryRequestMessage
+	HistoryResponseMessage
+	UploadBeginRequestMessage
+	UploadBeginResponseMessage
+	UploadChunkRequestMessage
+	UploadChunkResponseMessage
+	UploadCommitRequestMessage
+	UploadCommitResponseMessage
+	DownloadRequestMessage
+	DownloadResponseMessage
+	SetPresenceRequestMessage
+	SetPresenceResponseMessage
+	GetPresenceRequestMessage
+	GetPresenceResponseMessage
+	SubscribePresenceRequestMessage
+	SubscribePresenceResponseMessage
+	UnsubscribePresenceRequestMessage
+	UnsubscribePresenceResponseMessage
+	PresencePushMessage
+	SignalRequestMessage
+	SignalResponseMessage
+	SignalPushMessage
+	PullRequiredPushMessage
 )
 
 type Header struct {